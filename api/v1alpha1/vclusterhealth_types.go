@@ -23,6 +23,22 @@ import (
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// Condition types set on VClusterHealthStatus.
+// Ready, Degraded and Stalled follow kstatus conventions so GitOps tools can assess fleet health.
+const (
+	// ConditionReady is True when the last reconcile succeeded and every discovered vCluster is at the top level.
+	ConditionReady = "Ready"
+	// ConditionDegraded is True when at least one discovered vCluster is below the top level.
+	ConditionDegraded = "Degraded"
+	// ConditionStalled is True when the controller cannot observe the host cluster (e.g. list calls fail).
+	ConditionStalled = "Stalled"
+	// ConditionRulesValid reports whether every spec.rules expression compiled.
+	ConditionRulesValid = "RulesValid"
+	// ConditionExpectationsEvaluated reports whether each vCluster's config is read to decide which
+	// signals are expected. It is False when the manager runs without --read-vcluster-config.
+	ConditionExpectationsEvaluated = "ExpectationsEvaluated"
+)
+
 // DiscoveredCluster represents a vCluster discovered in the host cluster.
 type DiscoveredCluster struct {
 	// Name is the vCluster name (usually the Service name).
@@ -33,6 +49,266 @@ type DiscoveredCluster struct {
 	ServiceName string `json:"serviceName"`
 	// ServicePort is the API port exposed by the Service (typically 443).
 	ServicePort int32 `json:"servicePort"`
+	// Distro is the Kubernetes distribution of the control plane: k3s, k8s, k0s or eks.
+	// +optional
+	Distro string `json:"distro,omitempty"`
+	// VClusterVersion is the syncer version, read from the control-plane image tag.
+	// +optional
+	VClusterVersion string `json:"vclusterVersion,omitempty"`
+	// KubernetesVersion is the virtual cluster's Kubernetes version, read from the distro image tag.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Release is the latest Helm release of the vCluster, if it was installed with Helm.
+	// +optional
+	Release *HelmRelease `json:"release,omitempty"`
+}
+
+// HelmRelease describes the latest revision of a vCluster's Helm release.
+type HelmRelease struct {
+	// Chart is the chart name, e.g. vcluster or vcluster-k8s.
+	// +optional
+	Chart string `json:"chart,omitempty"`
+	// ChartVersion is the chart version, e.g. 0.20.0.
+	// +optional
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// AppVersion is the chart's appVersion.
+	// +optional
+	AppVersion string `json:"appVersion,omitempty"`
+	// Revision is the release revision.
+	Revision int32 `json:"revision"`
+	// Status is the Helm release status, e.g. deployed, failed or pending-upgrade.
+	Status string `json:"status"`
+	// LastDeployed is when the revision was last deployed.
+	// +optional
+	LastDeployed metav1.Time `json:"lastDeployed,omitempty"`
+}
+
+// SignalResult is the outcome of a single signal detector for one vCluster.
+type SignalResult struct {
+	// Name is the signal name reported by the detector (e.g. controlPlaneReady).
+	Name string `json:"name"`
+
+	// Status is True when the signal is observed, False when it is not, and Unknown when it could not be evaluated.
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is a CamelCase token describing why the signal has its status.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Evidence is a short human-readable description of what the detector observed.
+	// +optional
+	Evidence string `json:"evidence,omitempty"`
+}
+
+// SignalExpectation compares whether the vCluster's own config enables the feature behind a
+// signal with what the detector observed.
+type SignalExpectation struct {
+	// Name is the signal name.
+	Name string `json:"name"`
+
+	// Expected is false when the vCluster config disables the feature behind the signal.
+	// Only expected signals are scored.
+	Expected bool `json:"expected"`
+
+	// Observed is true when the signal's status is True.
+	Observed bool `json:"observed"`
+
+	// UnexpectedlyActive is true when a signal the config disables is observed anyway.
+	// +optional
+	UnexpectedlyActive bool `json:"unexpectedlyActive,omitempty"`
+}
+
+// RuleResult is the outcome of one spec.rules expression for one vCluster.
+type RuleResult struct {
+	// Name is the rule name from spec.rules.
+	Name string `json:"name"`
+
+	// Passed is true when the expression evaluated to true.
+	Passed bool `json:"passed"`
+
+	// Message explains an evaluation error, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ControlPlaneStatus reports the readiness of a vCluster's control-plane workload.
+type ControlPlaneStatus struct {
+	// Kind is the control-plane workload kind: StatefulSet, Deployment, or Pod when the
+	// control-plane pods have no resolvable owner.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the workload name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ReadyReplicas is the number of control-plane pods that are Running and Ready.
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// DesiredReplicas is the workload's desired replica count, or the number of control-plane
+	// pods when no owner was resolved.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// RecentRestarts is the number of control-plane container restarts within the restart window.
+	// +optional
+	RecentRestarts int32 `json:"recentRestarts,omitempty"`
+
+	// Issues lists control-plane containers that are crash-looping, were OOM-killed, or restarted
+	// more often than allowed within the restart window.
+	// +listType=atomic
+	// +optional
+	Issues []ContainerIssue `json:"issues,omitempty"`
+}
+
+// ContainerIssue describes an unstable control-plane container.
+type ContainerIssue struct {
+	// Pod is the control-plane pod name.
+	Pod string `json:"pod"`
+
+	// Container is the container name.
+	Container string `json:"container"`
+
+	// Reason is CrashLoopBackOff, OOMKilled or FrequentRestarts, the most severe that applies.
+	Reason string `json:"reason"`
+
+	// RecentRestarts is the number of restarts of this container within the restart window.
+	// +optional
+	RecentRestarts int32 `json:"recentRestarts,omitempty"`
+}
+
+// BackingStoreStatus reports the datastore of a vCluster.
+type BackingStoreStatus struct {
+	// Kind is Etcd (a separate etcd StatefulSet), Embedded (SQLite or embedded etcd on the
+	// control-plane volumes) or External (nothing observable on the host).
+	Kind string `json:"kind"`
+
+	// ReadyMembers is the number of ready datastore pods.
+	// +optional
+	ReadyMembers int32 `json:"readyMembers,omitempty"`
+
+	// DesiredMembers is the desired number of datastore pods. Quorum needs a majority of them.
+	// +optional
+	DesiredMembers int32 `json:"desiredMembers,omitempty"`
+
+	// Volumes lists the datastore's PersistentVolumeClaims.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Volumes []VolumeStatus `json:"volumes,omitempty"`
+}
+
+// VolumeStatus reports one PersistentVolumeClaim.
+type VolumeStatus struct {
+	// Name is the PersistentVolumeClaim name.
+	Name string `json:"name"`
+
+	// Phase is the claim phase (Pending, Bound, Lost), or NotFound.
+	Phase string `json:"phase"`
+
+	// UsedPercent is the share of the volume in use, if kubelet volume stats are enabled.
+	// +optional
+	UsedPercent *int32 `json:"usedPercent,omitempty"`
+}
+
+// WorkloadsStatus counts the pods a vCluster has synced to the host.
+type WorkloadsStatus struct {
+	// System counts synced pods from the vCluster's kube-system namespace.
+	System WorkloadCounts `json:"system"`
+
+	// Tenant counts every other synced pod, including pods without a vcluster.loft.sh/namespace label.
+	Tenant WorkloadCounts `json:"tenant"`
+
+	// Namespaces breaks the counts down by original vCluster namespace, largest first.
+	// +listType=map
+	// +listMapKey=namespace
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Namespaces []NamespaceWorkloads `json:"namespaces,omitempty"`
+
+	// OmittedNamespaces is the number of namespaces left out of Namespaces to keep it bounded.
+	// +optional
+	OmittedNamespaces int32 `json:"omittedNamespaces,omitempty"`
+}
+
+// WorkloadCounts counts synced pods by phase and readiness.
+type WorkloadCounts struct {
+	// Total is the number of synced pods.
+	Total int32 `json:"total"`
+
+	// Running, Pending, Succeeded, Failed and Unknown count the pods in each phase.
+	// +optional
+	Running int32 `json:"running,omitempty"`
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+	// +optional
+	Unknown int32 `json:"unknown,omitempty"`
+
+	// Ready is the number of Running pods that are Ready.
+	// +optional
+	Ready int32 `json:"ready,omitempty"`
+}
+
+// NamespaceWorkloads counts the synced pods of one vCluster namespace.
+type NamespaceWorkloads struct {
+	// Namespace is the namespace inside the vCluster.
+	Namespace string `json:"namespace"`
+
+	WorkloadCounts `json:",inline"`
+}
+
+// ProbeStatus is the outcome of the active API probe.
+type ProbeStatus struct {
+	// StatusCode is the HTTP status of GET /readyz, or 0 if no response was received.
+	// +optional
+	StatusCode int32 `json:"statusCode,omitempty"`
+
+	// LatencyMilliseconds is the /readyz round-trip time. Changes in latency alone do not trigger a status write.
+	// +optional
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// ServerVersion is the gitVersion reported by /version.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// Authenticated is true when credentials from the kubeconfig Secret were used.
+	// +optional
+	Authenticated bool `json:"authenticated,omitempty"`
+
+	// Error describes why the probe failed, if it did.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CertificatesStatus reports the earliest-expiring certificate of a vCluster.
+type CertificatesStatus struct {
+	// NotAfter is the earliest expiry among the checked certificates.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// Subject is the subject of the earliest-expiring certificate, e.g. CN=kube-apiserver.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// Source is the Secret and key holding it, e.g. vc-prod-certs/apiserver.crt.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// Checked counts the certificates parsed from the vCluster's Secrets.
+	Checked int32 `json:"checked"`
+}
+
+// EndpointsStatus counts the endpoints backing the vCluster API Service port.
+type EndpointsStatus struct {
+	// Ready is the number of ready endpoints serving the port.
+	Ready int32 `json:"ready"`
+
+	// Total is the number of endpoints serving the port, ready or not.
+	Total int32 `json:"total"`
 }
 
 // SyncCoverage summarizes which vCluster sync features are active (host-side signals only).
@@ -40,12 +316,51 @@ type SyncCoverage struct {
 	// ClusterName is the vCluster name (e.g., vc-prod).
 	ClusterName string `json:"clusterName"`
 
-	// ControlPlaneReady indicates the vCluster control-plane pod (vc-prod-0) is running & ready.
+	// Namespace is the host namespace of the vCluster. Together with ClusterName it identifies
+	// the vCluster when several namespaces are selected.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ControlPlaneReady indicates every desired vCluster control-plane replica is running & ready.
 	ControlPlaneReady bool `json:"controlPlaneReady"`
 
-	// ApiSync indicates the vCluster API Service exists (vc-prod).
+	// ControlPlaneStable indicates no control-plane container is crash-looping, was recently
+	// OOM-killed, or restarted more than spec.controlPlane.maxRestarts times within the window.
+	ControlPlaneStable bool `json:"controlPlaneStable"`
+
+	// ControlPlane reports ready/desired control-plane replicas, so a partial HA outage (2/3)
+	// can be told apart from a full one (0/3).
+	// +optional
+	ControlPlane *ControlPlaneStatus `json:"controlPlane,omitempty"`
+
+	// ApiSync indicates at least one ready endpoint serves the vCluster API Service port (vc-prod:443).
 	ApiSync bool `json:"apiSync"`
 
+	// ApiEndpoints counts the ready and total EndpointSlice endpoints serving the API Service port.
+	// +optional
+	ApiEndpoints *EndpointsStatus `json:"apiEndpoints,omitempty"`
+
+	// ReleaseHealthy indicates the latest Helm release is deployed, not failed or stuck pending.
+	// It is true for vClusters not installed with Helm, and when the manager runs without
+	// --read-vcluster-config (the releaseHealthy signal then reads CheckDisabled).
+	ReleaseHealthy bool `json:"releaseHealthy"`
+
+	// BackingStoreHealthy indicates the datastore holds quorum and its volumes are bound and
+	// below spec.backingStore.capacityThresholdPercent.
+	BackingStoreHealthy bool `json:"backingStoreHealthy"`
+
+	// BackingStore reports datastore members and volumes.
+	// +optional
+	BackingStore *BackingStoreStatus `json:"backingStore,omitempty"`
+
+	// Probe is the result of the active API probe, set when spec.probe is configured.
+	// +optional
+	Probe *ProbeStatus `json:"probe,omitempty"`
+
+	// Certificates reports the earliest-expiring certificate, set when spec.certificates is configured.
+	// +optional
+	Certificates *CertificatesStatus `json:"certificates,omitempty"`
+
 	// DnsSync indicates kube-system DNS mapping Service exists (kube-dns-x-*-x-vc-prod).
 	DnsSync bool `json:"dnsSync"`
 
@@ -55,16 +370,53 @@ type SyncCoverage struct {
 	// WorkloadSync is a legacy aggregate. It is true if either SystemWorkloadSync or TenantWorkloadSync is true.
 	WorkloadSync bool `json:"workloadSync"`
 
-	// SystemWorkloadSync is true if kube-system workloads (e.g. CoreDNS) are observed synced on the host.
+	// SystemWorkloadSync is true if kube-system workloads (e.g. CoreDNS) are synced on the host and
+	// at least spec.workloads.minHealthyPercent of them are Ready or Succeeded.
 	SystemWorkloadSync bool `json:"systemWorkloadSync"`
 
-	// TenantWorkloadSync is true if non-kube-system tenant workloads are observed synced on the host.
+	// TenantWorkloadSync is true if non-kube-system tenant workloads are synced on the host and
+	// at least spec.workloads.minHealthyPercent of them are Ready or Succeeded.
 	TenantWorkloadSync bool `json:"tenantWorkloadSync"`
 
-	// Score is a simple percentage (0–100) derived from the signals above.
+	// Workloads counts synced pods by phase, readiness and original namespace.
+	// +optional
+	Workloads *WorkloadsStatus `json:"workloads,omitempty"`
+
+	// Signals lists the result of every registered detector, including ones without a dedicated field above.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Signals []SignalResult `json:"signals,omitempty"`
+
+	// ConfigSource names the vCluster config the expectations were derived from, e.g.
+	// Secret/vc-config-vc-prod. Empty when no config was found and every signal is expected.
+	// +optional
+	ConfigSource string `json:"configSource,omitempty"`
+
+	// Expectations lists, per signal, whether it is expected from the vCluster config and observed.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Expectations []SignalExpectation `json:"expectations,omitempty"`
+
+	// Conditions explain every signal, one condition per signal. The type is the signal name with
+	// an upper-case first letter (e.g. DnsSync), and lastTransitionTime shows how long it has held.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Rules lists the result of every compiled spec.rules expression.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Rules []RuleResult `json:"rules,omitempty"`
+
+	// Score is a simple percentage (0–100) derived from the expected signals above.
 	Score int32 `json:"score"`
 
-	// Level is a human-friendly summary: None | Partial | Full.
+	// Level is a human-friendly summary: None | Partial | Full, a level named in spec.scoring.levels,
+	// or the level of the first failing spec.rules entry that sets one.
 	Level string `json:"level"`
 
 	// LastChecked is when this coverage was last evaluated.
@@ -72,6 +424,168 @@ type SyncCoverage struct {
 	LastChecked metav1.Time `json:"lastChecked,omitempty"`
 }
 
+// SignalPolicy tunes how a single signal contributes to the score.
+type SignalPolicy struct {
+	// Name is the signal name (e.g. controlPlaneReady, nodeSync).
+	Name string `json:"name"`
+
+	// Weight is the signal's share of the score relative to other signals.
+	// Signals without a policy entry weigh 1. A weight of 0 makes the signal informational.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Required caps the level at the lowest threshold whenever this signal is not present,
+	// regardless of the score.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// LevelThreshold names the level reached at or above a minimum score.
+type LevelThreshold struct {
+	// Name is the level reported in SyncCoverage (e.g. Healthy).
+	Name string `json:"name"`
+
+	// MinScore is the inclusive lower bound (0–100) for this level.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinScore int32 `json:"minScore"`
+}
+
+// ScoringPolicy controls how signals roll up into Score and Level.
+// Every evaluated signal is scored, including opt-in ones once enabled; a signal whose check is
+// disabled (reason CheckDisabled) is not. Use a weight of 0 to keep a signal informational.
+type ScoringPolicy struct {
+	// Signals overrides weight and requiredness per signal.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Signals []SignalPolicy `json:"signals,omitempty"`
+
+	// Levels are the named score thresholds, e.g. Healthy ≥ 90, Degraded ≥ 50, Critical ≥ 0.
+	// A score below every threshold gets the level with the lowest MinScore.
+	// If empty, the levels are None | Partial | Full.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Levels []LevelThreshold `json:"levels,omitempty"`
+}
+
+// HealthRule is a custom CEL health check evaluated against every vCluster.
+//
+// The expression must return a bool and can use:
+//   - apiSync, controlPlaneReady, controlPlaneStable, backingStoreHealthy, releaseHealthy, dnsSync, nodeSync,
+//     workloadSync, systemWorkloadSync, tenantWorkloadSync (bool)
+//   - signals (map of signal name to bool, covers every registered detector)
+//   - score (int), level (string)
+//   - name, namespace (string), labels (map of string), age (duration since the API Service was created)
+//
+// Example: controlPlaneReady && (tenantWorkloadSync || age < duration('1h'))
+type HealthRule struct {
+	// Name identifies the rule in status.
+	Name string `json:"name"`
+
+	// Expression is the CEL expression to evaluate.
+	// +kubebuilder:validation:MinLength=1
+	Expression string `json:"expression"`
+
+	// Level, if set, replaces the computed level when the rule does not pass.
+	// +optional
+	Level string `json:"level,omitempty"`
+}
+
+// BackingStoreSpec tunes the backingStoreHealthy check.
+type BackingStoreSpec struct {
+	// CapacityThresholdPercent is the volume usage at which backingStoreHealthy turns false.
+	// Usage is only known when the controller runs with --volume-stats. Defaults to 85.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	CapacityThresholdPercent *int32 `json:"capacityThresholdPercent,omitempty"`
+}
+
+// VersionPolicySpec restricts which vCluster versions a fleet may run.
+type VersionPolicySpec struct {
+	// Constraint is a semver constraint every vCluster version must satisfy,
+	// e.g. ">= 0.19.0, < 0.22.0" or "~0.20".
+	// +optional
+	Constraint string `json:"constraint,omitempty"`
+
+	// Denied lists versions that are out of policy even if they satisfy Constraint,
+	// e.g. releases with known vulnerabilities.
+	// +listType=set
+	// +optional
+	Denied []string `json:"denied,omitempty"`
+}
+
+// CertificatesSpec enables the certificatesValid check.
+type CertificatesSpec struct {
+	// WarningWindowDays is how many days before the earliest certificate expires certificatesValid
+	// reads ExpiringSoon. The signal stays true until the certificate expires. Defaults to 30.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WarningWindowDays *int32 `json:"warningWindowDays,omitempty"`
+}
+
+// WorkloadsSpec tunes the systemWorkloadSync and tenantWorkloadSync checks.
+type WorkloadsSpec struct {
+	// MinHealthyPercent is the share of synced pods that must be Running and Ready, or Succeeded,
+	// for a workload signal to be true. Defaults to 50.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinHealthyPercent *int32 `json:"minHealthyPercent,omitempty"`
+}
+
+// ProbeSpec enables the active API probe.
+type ProbeSpec struct {
+	// TimeoutSeconds bounds each probe of one vCluster, both requests included. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// KubeconfigSecretPrefix prefixes the vCluster name to form the kubeconfig Secret in the
+	// vCluster's namespace. Defaults to "vc-", the Secret the vCluster chart creates.
+	// +optional
+	KubeconfigSecretPrefix *string `json:"kubeconfigSecretPrefix,omitempty"`
+}
+
+// ControlPlaneSpec selects the control-plane pods of each discovered vCluster and tunes the
+// controlPlaneStable check.
+type ControlPlaneSpec struct {
+	// PodSelector matches control-plane pods in the vCluster's namespace. If unset, app=vcluster is used.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// ClusterLabel is the pod label whose value is the vCluster name. If unset, "release" is used.
+	// +optional
+	ClusterLabel string `json:"clusterLabel,omitempty"`
+
+	// RestartWindowSeconds is the sliding window in which container restarts are counted.
+	// Defaults to 3600.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	RestartWindowSeconds *int32 `json:"restartWindowSeconds,omitempty"`
+
+	// MaxRestarts is how many restarts of one container the window tolerates before
+	// controlPlaneStable turns false. Defaults to 2.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+}
+
+// DiscoverySpec selects which host Services are vCluster API Services.
+type DiscoverySpec struct {
+	// ServiceSelector matches vCluster API Services. If unset, app=vcluster is used.
+	// +optional
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+
+	// ExcludeSelector drops Services matched by ServiceSelector, e.g. helper or headless Services.
+	// +optional
+	ExcludeSelector *metav1.LabelSelector `json:"excludeSelector,omitempty"`
+}
+
 // VClusterHealthSpec defines the desired state of VClusterHealth
 type VClusterHealthSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -86,6 +600,66 @@ type VClusterHealthSpec struct {
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 
+	// NamespaceSelector selects host namespaces by their labels, e.g. tenant=true.
+	// If NamespaceSelector or NamespacePatterns is set, Namespace is ignored; if both are set,
+	// a namespace must match both.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// NamespacePatterns selects host namespaces by name using globs (*, ?, [a-z]).
+	// A pattern starting with "!" excludes matching namespaces, e.g. ["team-*", "!team-sandbox"].
+	// With only exclusions, every other namespace is included.
+	// +optional
+	NamespacePatterns []string `json:"namespacePatterns,omitempty"`
+
+	// Discovery customises how vCluster API Services are found.
+	// If unset, Services labelled app=vcluster are discovered.
+	// +optional
+	Discovery *DiscoverySpec `json:"discovery,omitempty"`
+
+	// ControlPlane customises how control-plane pods are found. If unset, pods labelled
+	// app=vcluster and release=<vcluster name> are used, falling back to the pod <vcluster name>-0.
+	// The owning StatefulSet or Deployment is resolved from owner references.
+	// +optional
+	ControlPlane *ControlPlaneSpec `json:"controlPlane,omitempty"`
+
+	// BackingStore tunes the datastore check.
+	// +optional
+	BackingStore *BackingStoreSpec `json:"backingStore,omitempty"`
+
+	// Workloads tunes how many synced pods must be healthy for the workload signals.
+	// +optional
+	Workloads *WorkloadsSpec `json:"workloads,omitempty"`
+
+	// VersionPolicy checks every vCluster's detected version, reported as the upToDate signal
+	// and counted in status.outOfPolicyClusters.
+	// +optional
+	VersionPolicy *VersionPolicySpec `json:"versionPolicy,omitempty"`
+
+	// Certificates enables the certificatesValid signal: the x509 certificates in every vCluster's
+	// <name>-certs Secret and kubeconfig Secret are parsed and the earliest notAfter is reported.
+	// The kubeconfig Secret is named by spec.probe.kubeconfigSecretPrefix, "vc-" by default.
+	// +optional
+	Certificates *CertificatesSpec `json:"certificates,omitempty"`
+
+	// Probe enables an active probe of every vCluster's /readyz and /version endpoints over TLS,
+	// reported as the apiReachable signal. Credentials come from the vCluster's kubeconfig Secret
+	// when it exists; otherwise the probe is anonymous and does not verify the serving certificate.
+	// +optional
+	Probe *ProbeSpec `json:"probe,omitempty"`
+
+	// Scoring customises signal weights and level thresholds.
+	// If unset, every signal weighs the same and levels are None | Partial | Full.
+	// +optional
+	Scoring *ScoringPolicy `json:"scoring,omitempty"`
+
+	// Rules are custom CEL health checks evaluated per vCluster.
+	// Rules that fail to compile are skipped and reported in the RulesValid condition.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Rules []HealthRule `json:"rules,omitempty"`
+
 	// SyncCoverage reports host-observed sync signals per vCluster.
 	// +optional
 	SyncCoverage []SyncCoverage `json:"syncCoverage,omitempty"`
@@ -100,16 +674,22 @@ type VClusterHealthStatus struct {
 	// +optional
 	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
 
+	// ObservedGeneration is the metadata.generation the status was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// For Kubernetes API conventions, see:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
 
 	// conditions represent the current state of the VClusterHealth resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
-	// Standard condition types include:
-	// - "Available": the resource is fully functional
-	// - "Progressing": the resource is being created or updated
-	// - "Degraded": the resource failed to reach or maintain its desired state
+	// Condition types set by the controller:
+	// - "Ready": the last reconcile succeeded and every vCluster is at the top level
+	// - "Degraded": at least one vCluster is below the top level
+	// - "Stalled": the controller cannot observe the host cluster, or the namespace or discovery selection
+	//   or version policy is invalid
+	// - "RulesValid": every spec.rules expression compiled (only when rules are set)
 	//
 	// The status of each condition is one of True, False, or Unknown.
 	// +listType=map
@@ -124,6 +704,11 @@ type VClusterHealthStatus struct {
 	// SyncCoverage reports host-observed sync signals per vCluster.
 	// +optional
 	SyncCoverage []SyncCoverage `json:"syncCoverage,omitempty"`
+
+	// OutOfPolicyClusters counts the vClusters whose version violates spec.versionPolicy.
+	// Unset when no version policy is configured.
+	// +optional
+	OutOfPolicyClusters *int32 `json:"outOfPolicyClusters,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -132,10 +717,12 @@ type VClusterHealthStatus struct {
 // VClusterHealth is the Schema for the vclusterhealths API
 // adding print column using kube builder for additional fields
 // +kubebuilder:printcolumn:name="TargetNS",type="string",JSONPath=".spec.namespace",description="Namespace selector (vcluster, *, all)"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Fleet readiness"
 // +kubebuilder:printcolumn:name="Score",type="integer",JSONPath=".status.syncCoverage[0].score",description="Score (first entry)"
 // +kubebuilder:printcolumn:name="Level",type="string",JSONPath=".status.syncCoverage[0].level",description="Level (first entry)"
 // +kubebuilder:printcolumn:name="SysWL",type="boolean",JSONPath=".status.syncCoverage[0].systemWorkloadSync",description="System workload sync (first entry)"
 // +kubebuilder:printcolumn:name="TenantWL",type="boolean",JSONPath=".status.syncCoverage[0].tenantWorkloadSync",description="Tenant workload sync (first entry)"
+// +kubebuilder:printcolumn:name="OutOfPolicy",type="integer",JSONPath=".status.outOfPolicyClusters",description="vClusters violating the version policy",priority=1
 // +kubebuilder:printcolumn:name="LastUpdated",type="date",JSONPath=".status.lastUpdated",description="Last status update"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type VClusterHealth struct {
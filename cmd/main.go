@@ -20,11 +20,13 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -61,6 +63,12 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var watchDebounce time.Duration
+	var statusHeartbeat time.Duration
+	var leanCache bool
+	var podCacheSelector string
+	var volumeStats bool
+	var readVClusterConfig bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -79,6 +87,22 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&watchDebounce, "watch-debounce", controller.DefaultWatchDebounce,
+		"How long Pod/Service events wait before triggering a reconcile, so bursts collapse into one.")
+	flag.DurationVar(&statusHeartbeat, "status-heartbeat", controller.DefaultStatusHeartbeat,
+		"How often an unchanged VClusterHealth status is rewritten to refresh lastUpdated.")
+	flag.BoolVar(&leanCache, "lean-cache", true,
+		"If set, cached Pods and Services are stripped of managedFields, volumes and container specs "+
+			"the detectors do not read. Pods a vCluster did not sync keep container images and args.")
+	flag.StringVar(&podCacheSelector, "pod-cache-selector", "",
+		"Optional label selector restricting which Pods are cached. It must match both vCluster "+
+			"control-plane pods and synced workload pods.")
+	flag.BoolVar(&volumeStats, "volume-stats", false,
+		"If set, datastore volume usage is read from kubelet stats through the nodes/proxy API. "+
+			"Requires config/rbac/volume_stats_role.yaml.")
+	flag.BoolVar(&readVClusterConfig, "read-vcluster-config", false,
+		"If set, each vCluster's Helm release and vc-config Secret are read for the releaseHealthy signal "+
+			"and per-cluster expectations. Requires config/rbac/vcluster_config_role.yaml.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -154,8 +178,18 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	var podSelector labels.Selector
+	if podCacheSelector != "" {
+		var err error
+		if podSelector, err = labels.Parse(podCacheSelector); err != nil {
+			setupLog.Error(err, "invalid --pod-cache-selector", "selector", podCacheSelector)
+			os.Exit(1)
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  controller.HostCacheOptions(leanCache, podSelector),
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -178,9 +212,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	var volumeStatsSource controller.VolumeStatsSource
+	if volumeStats {
+		volumeStatsSource, err = controller.NewKubeletVolumeStats(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to set up volume stats")
+			os.Exit(1)
+		}
+	}
+
 	if err := (&controller.VClusterHealthReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		WatchDebounce:      watchDebounce,
+		StatusHeartbeat:    statusHeartbeat,
+		Recorder:           mgr.GetEventRecorder("vclusterhealth-controller"),
+		APIReader:          mgr.GetAPIReader(),
+		VolumeStats:        volumeStatsSource,
+		ReadVClusterConfig: readVClusterConfig,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VClusterHealth")
 		os.Exit(1)
@@ -0,0 +1,258 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalBackingStoreHealthy reports the quorum and volumes of a vCluster's datastore.
+const SignalBackingStoreHealthy = "backingStoreHealthy"
+
+// Backing store kinds.
+const (
+	BackingStoreEtcd     = "Etcd"
+	BackingStoreEmbedded = "Embedded"
+	BackingStoreExternal = "External"
+)
+
+// DefaultCapacityThresholdPercent is the volume usage at which the backing store is flagged
+// when spec.backingStore.capacityThresholdPercent is unset.
+const DefaultCapacityThresholdPercent = 85
+
+// capacityThresholdFor returns the volume usage threshold from spec.backingStore.
+func capacityThresholdFor(spec fleetv1alpha1.VClusterHealthSpec) int32 {
+	if bs := spec.BackingStore; bs != nil && bs.CapacityThresholdPercent != nil {
+		return *bs.CapacityThresholdPercent
+	}
+	return DefaultCapacityThresholdPercent
+}
+
+// BackingStoreState is the resolved datastore of one vCluster.
+type BackingStoreState struct {
+	// Kind is one of BackingStoreEtcd, BackingStoreEmbedded or BackingStoreExternal.
+	Kind string
+	// Workload is the StatefulSet holding the data; empty for BackingStoreExternal.
+	Workload string
+	// Ready and Desired count the datastore pods.
+	Ready   int32
+	Desired int32
+	// Volumes are the datastore's PersistentVolumeClaims, one per claim template and replica.
+	Volumes []VolumeState
+}
+
+// VolumeState is one datastore PersistentVolumeClaim.
+type VolumeState struct {
+	Name string
+	// Phase is the claim phase, or "NotFound".
+	Phase string
+	// UsedPercent is the volume usage, nil when unknown.
+	UsedPercent *int32
+}
+
+// Status returns the state as reported in SyncCoverage.
+func (s *BackingStoreState) Status() *fleetv1alpha1.BackingStoreStatus {
+	st := &fleetv1alpha1.BackingStoreStatus{Kind: s.Kind, ReadyMembers: s.Ready, DesiredMembers: s.Desired}
+	for _, v := range s.Volumes {
+		st.Volumes = append(st.Volumes, fleetv1alpha1.VolumeStatus{Name: v.Name, Phase: v.Phase, UsedPercent: v.UsedPercent})
+	}
+	return st
+}
+
+// quorum is the number of ready members a datastore of Desired members needs.
+func (s *BackingStoreState) quorum() int32 {
+	return s.Desired/2 + 1
+}
+
+// BackingStore resolves the datastore of c: the StatefulSet <name>-etcd if it exists, else the
+// control-plane StatefulSet if it has volume claim templates (SQLite or embedded etcd), else
+// External. The result is memoised.
+func (s *HostSnapshot) BackingStore(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*BackingStoreState, error) {
+	key := c.Namespace + "/" + c.Name
+	if st, ok := s.backingStores[key]; ok {
+		return st, nil
+	}
+
+	st := &BackingStoreState{Kind: BackingStoreExternal}
+	var sts appsv1.StatefulSet
+	found, err := s.getStatefulSet(ctx, c.Namespace, c.Name+"-etcd", &sts)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		st.Kind = BackingStoreEtcd
+	} else {
+		cp, err := s.ControlPlane(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		if cp.Kind == "StatefulSet" {
+			found, err = s.getStatefulSet(ctx, c.Namespace, cp.Name, &sts)
+			if err != nil {
+				return nil, err
+			}
+			if found && len(sts.Spec.VolumeClaimTemplates) > 0 {
+				st.Kind = BackingStoreEmbedded
+			}
+		}
+	}
+	if st.Kind != BackingStoreExternal {
+		if err := s.resolveMembers(ctx, &sts, st); err != nil {
+			return nil, err
+		}
+	}
+	s.backingStores[key] = st
+	return st, nil
+}
+
+func (s *HostSnapshot) getStatefulSet(ctx context.Context, namespace, name string, sts *appsv1.StatefulSet) (bool, error) {
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		s.recordWorkloadErr(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// resolveMembers fills in the pods and claims of sts. StatefulSet pods are <sts>-<ordinal> and
+// their claims <template>-<sts>-<ordinal>.
+func (s *HostSnapshot) resolveMembers(ctx context.Context, sts *appsv1.StatefulSet, st *BackingStoreState) error {
+	st.Workload = sts.Name
+	st.Desired = replicasOrDefault(sts.Spec.Replicas)
+	for i := range st.Desired {
+		member := sts.Name + "-" + strconv.Itoa(int(i))
+		pod, err := s.Pod(ctx, sts.Namespace, member)
+		if err != nil {
+			return err
+		}
+		if pod != nil && pod.DeletionTimestamp.IsZero() && pod.Status.Phase == corev1.PodRunning && podReady(pod) {
+			st.Ready++
+		}
+		for _, tmpl := range sts.Spec.VolumeClaimTemplates {
+			v, err := s.volume(ctx, sts.Namespace, tmpl.Name+"-"+member, pod)
+			if err != nil {
+				return err
+			}
+			st.Volumes = append(st.Volumes, v)
+		}
+	}
+	return nil
+}
+
+// volume reads one claim and, with volume stats enabled, its usage on the node of pod.
+func (s *HostSnapshot) volume(ctx context.Context, namespace, name string, pod *corev1.Pod) (VolumeState, error) {
+	v := VolumeState{Name: name, Phase: "NotFound"}
+	var pvc corev1.PersistentVolumeClaim
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v, nil
+		}
+		s.recordVolumeErr(err)
+		return v, err
+	}
+	v.Phase = string(pvc.Status.Phase)
+	if pod == nil || pod.Spec.NodeName == "" {
+		return v, nil
+	}
+	usage, ok := s.nodeVolumeUsage(ctx, pod.Spec.NodeName)[types.NamespacedName{Namespace: namespace, Name: name}]
+	if ok && usage.CapacityBytes > 0 {
+		pct := int32(usage.UsedBytes * 100 / usage.CapacityBytes)
+		v.UsedPercent = &pct
+	}
+	return v, nil
+}
+
+// nodeVolumeUsage returns the memoised volume stats of node, or nil if volume stats are
+// disabled or unavailable. Stats are best effort and never stall the fleet.
+func (s *HostSnapshot) nodeVolumeUsage(ctx context.Context, node string) map[types.NamespacedName]VolumeUsage {
+	if s.volumeStats == nil {
+		return nil
+	}
+	if usage, ok := s.nodeStats[node]; ok {
+		return usage
+	}
+	usage, err := s.volumeStats.VolumeUsage(ctx, node)
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("volume stats unavailable", "node", node, "error", err.Error())
+	}
+	s.nodeStats[node] = usage
+	return usage
+}
+
+// backingStoreDetector reports datastore quorum, unbound claims and full volumes (see HostSnapshot.BackingStore).
+type backingStoreDetector struct{}
+
+func (backingStoreDetector) Name() string { return SignalBackingStoreHealthy }
+
+func (backingStoreDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	bs, err := snap.BackingStore(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if bs.Kind == BackingStoreExternal {
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "ExternalDatastore",
+			Evidence: fmt.Sprintf("no etcd StatefulSet or data volumes for %s; the datastore is external or ephemeral", c.Name),
+		}
+	}
+	members := fmt.Sprintf("%s %s: %d/%d members ready", bs.Kind, bs.Workload, bs.Ready, bs.Desired)
+	if bs.Ready < bs.quorum() {
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "QuorumLost",
+			Evidence: fmt.Sprintf("%s, quorum needs %d", members, bs.quorum()),
+		}
+	}
+	for _, v := range bs.Volumes {
+		if v.Phase != string(corev1.ClaimBound) {
+			return DetectorResult{
+				Status:   metav1.ConditionFalse,
+				Reason:   "VolumeUnbound",
+				Evidence: fmt.Sprintf("PersistentVolumeClaim %s/%s is %s", c.Namespace, v.Name, v.Phase),
+			}
+		}
+	}
+	for _, v := range bs.Volumes {
+		if v.UsedPercent != nil && *v.UsedPercent >= snap.capacityThreshold {
+			return DetectorResult{
+				Status:   metav1.ConditionFalse,
+				Reason:   "VolumeNearCapacity",
+				Evidence: fmt.Sprintf("PersistentVolumeClaim %s/%s is %d%% full (threshold %d%%)", c.Namespace, v.Name, *v.UsedPercent, snap.capacityThreshold),
+			}
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionTrue,
+		Reason:   "Healthy",
+		Evidence: fmt.Sprintf("%s, %d volumes bound", members, len(bs.Volumes)),
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// staticVolumeStats serves fixed volume usage for every node.
+type staticVolumeStats map[types.NamespacedName]VolumeUsage
+
+func (s staticVolumeStats) VolumeUsage(context.Context, string) (map[types.NamespacedName]VolumeUsage, error) {
+	return s, nil
+}
+
+var _ = Describe("backing store", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+
+	dataStatefulSet := func(name string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:             ptr.To(replicas),
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+			},
+		}
+	}
+	member := func(name string, ready bool, lbls map[string]string) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster", Labels: lbls},
+			Spec:       corev1.PodSpec{NodeName: "node-a"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ready {
+			p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return p
+	}
+	claim := func(name string, phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+		}
+	}
+	etcdFleet := func(ready ...bool) []client.Object {
+		objs := []client.Object{dataStatefulSet("vc-prod-etcd", int32(len(ready)))}
+		for i, r := range ready {
+			name := fmt.Sprintf("vc-prod-etcd-%d", i)
+			objs = append(objs, member(name, r, nil), claim("data-"+name, corev1.ClaimBound))
+		}
+		return objs
+	}
+
+	It("reports quorum of a separate etcd StatefulSet", func() {
+		snap := newTestSnapshot(etcdFleet(true, true, false)...)
+		res := backingStoreDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Evidence).To(Equal("Etcd vc-prod-etcd: 2/3 members ready, 3 volumes bound"))
+
+		bs, err := snap.BackingStore(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bs.Status().Volumes).To(HaveLen(3))
+
+		res = backingStoreDetector{}.Evaluate(ctx, cluster, newTestSnapshot(etcdFleet(true, false, false)...))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("QuorumLost"))
+		Expect(res.Evidence).To(Equal("Etcd vc-prod-etcd: 1/3 members ready, quorum needs 2"))
+	})
+
+	It("flags an unbound data volume of an embedded datastore", func() {
+		cp := member("vc-prod-0", true, map[string]string{"app": "vcluster", "release": "vc-prod"})
+		cp.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "vc-prod", Controller: ptr.To(true)}}
+		snap := newTestSnapshot(dataStatefulSet("vc-prod", 1), cp, claim("data-vc-prod-0", corev1.ClaimPending))
+
+		res := backingStoreDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("VolumeUnbound"))
+		Expect(res.Evidence).To(Equal("PersistentVolumeClaim vcluster/data-vc-prod-0 is Pending"))
+
+		bs, err := snap.BackingStore(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bs.Kind).To(Equal(BackingStoreEmbedded))
+	})
+
+	It("flags volumes near capacity when volume stats are enabled", func() {
+		stats := staticVolumeStats{{Namespace: "vcluster", Name: "data-vc-prod-etcd-0"}: {UsedBytes: 90, CapacityBytes: 100}}
+		snap := newTestSnapshot(etcdFleet(true)...)
+		snap.volumeStats = stats
+		res := backingStoreDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("VolumeNearCapacity"))
+		Expect(res.Evidence).To(Equal("PersistentVolumeClaim vcluster/data-vc-prod-etcd-0 is 90% full (threshold 85%)"))
+
+		snap = newTestSnapshot(etcdFleet(true)...)
+		snap.volumeStats = stats
+		snap.capacityThreshold = capacityThresholdFor(fleetv1alpha1.VClusterHealthSpec{
+			BackingStore: &fleetv1alpha1.BackingStoreSpec{CapacityThresholdPercent: ptr.To[int32](95)},
+		})
+		Expect(backingStoreDetector{}.Evaluate(ctx, cluster, snap).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("treats a vCluster without datastore volumes as external", func() {
+		res := backingStoreDetector{}.Evaluate(ctx, cluster, newTestSnapshot())
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("ExternalDatastore"))
+	})
+
+	It("parses claim usage from the kubelet stats summary", func() {
+		usage, err := parseVolumeUsage([]byte(`{"pods":[{"volume":[
+			{"name":"data","pvcRef":{"name":"data-vc-prod-0","namespace":"vcluster"},"usedBytes":30,"capacityBytes":120},
+			{"name":"tmp","usedBytes":1,"capacityBytes":2}
+		]}]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usage).To(Equal(map[types.NamespacedName]VolumeUsage{
+			{Namespace: "vcluster", Name: "data-vc-prod-0"}: {UsedBytes: 30, CapacityBytes: 120},
+		}))
+	})
+})
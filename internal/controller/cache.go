@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HostCacheOptions returns manager cache options for the host Pods, Services, EndpointSlices,
+// PersistentVolumeClaims and control-plane workloads the detectors read.
+//
+// With lean set, objects are slimmed before they are stored: managedFields and the
+// last-applied-configuration annotation are dropped everywhere, volumes are dropped from Pods,
+// container specs are dropped from pods synced by a vCluster while every other Pod keeps their
+// images, command and args, and StatefulSets and Deployments lose their pod template. Control-plane
+// pods are never synced pods, so whichever selector finds them, their images still reveal the
+// distro.
+//
+// A non-nil podSelector restricts the Pod cache to matching Pods. It must match both the
+// control-plane pods and the synced workload pods, otherwise their signals read as missing.
+func HostCacheOptions(lean bool, podSelector labels.Selector) cache.Options {
+	opts := cache.Options{ByObject: map[client.Object]cache.ByObject{}}
+
+	podOpts := cache.ByObject{Label: podSelector}
+	svcOpts := cache.ByObject{}
+	sliceOpts := cache.ByObject{}
+	pvcOpts := cache.ByObject{}
+	workloadOpts := cache.ByObject{}
+	if lean {
+		podOpts.Transform = transformPod
+		svcOpts.Transform = transformService
+		sliceOpts.Transform = transformEndpointSlice
+		pvcOpts.Transform = transformMeta
+		workloadOpts.Transform = transformWorkload
+	}
+	opts.ByObject[&corev1.Pod{}] = podOpts
+	opts.ByObject[&corev1.Service{}] = svcOpts
+	opts.ByObject[&discoveryv1.EndpointSlice{}] = sliceOpts
+	opts.ByObject[&corev1.PersistentVolumeClaim{}] = pvcOpts
+	opts.ByObject[&appsv1.StatefulSet{}] = workloadOpts
+	opts.ByObject[&appsv1.Deployment{}] = workloadOpts
+	return opts
+}
+
+// transformPod slims a Pod down to what the detectors read. Non-Pod inputs are returned unchanged.
+func transformPod(in any) (any, error) {
+	pod, ok := in.(*corev1.Pod)
+	if !ok {
+		return in, nil
+	}
+	stripMeta(&pod.ObjectMeta)
+	pod.Spec.Volumes = nil
+	pod.Spec.EphemeralContainers = nil
+
+	if isSyncedPod(pod) {
+		pod.Spec.Containers = nil
+		pod.Spec.InitContainers = nil
+		return pod, nil
+	}
+
+	// Other pods, control-plane pods among them, keep image, command and args for distro detection;
+	// env and mounts are never read.
+	for _, list := range [][]corev1.Container{pod.Spec.Containers, pod.Spec.InitContainers} {
+		for i := range list {
+			list[i].Env = nil
+			list[i].EnvFrom = nil
+			list[i].VolumeMounts = nil
+			list[i].VolumeDevices = nil
+		}
+	}
+	return pod, nil
+}
+
+// isSyncedPod reports whether pod was synced from a vCluster: it carries a vCluster owner label
+// and is not a chart control-plane pod (app=vcluster).
+func isSyncedPod(pod *corev1.Pod) bool {
+	if pod.Labels["app"] == "vcluster" {
+		return false
+	}
+	return slices.ContainsFunc(vclusterOwnerLabelKeys, func(k string) bool { return pod.Labels[k] != "" })
+}
+
+// transformService drops metadata the detectors never read. Non-Service inputs are returned unchanged.
+func transformService(in any) (any, error) {
+	svc, ok := in.(*corev1.Service)
+	if !ok {
+		return in, nil
+	}
+	stripMeta(&svc.ObjectMeta)
+	return svc, nil
+}
+
+// transformEndpointSlice drops metadata the detectors never read. Other inputs are returned unchanged.
+func transformEndpointSlice(in any) (any, error) {
+	if s, ok := in.(*discoveryv1.EndpointSlice); ok {
+		stripMeta(&s.ObjectMeta)
+	}
+	return in, nil
+}
+
+// transformMeta drops metadata the detectors never read from any object.
+func transformMeta(in any) (any, error) {
+	if o, ok := in.(metav1.Object); ok {
+		o.SetManagedFields(nil)
+		if ann := o.GetAnnotations(); ann != nil {
+			delete(ann, corev1.LastAppliedConfigAnnotation)
+		}
+	}
+	return in, nil
+}
+
+// transformWorkload drops the pod template from StatefulSets and Deployments; only the replica
+// count and the names of volume claim templates are read. Other inputs are returned unchanged.
+func transformWorkload(in any) (any, error) {
+	switch w := in.(type) {
+	case *appsv1.StatefulSet:
+		stripMeta(&w.ObjectMeta)
+		w.Spec.Template = corev1.PodTemplateSpec{}
+		for i, t := range w.Spec.VolumeClaimTemplates {
+			w.Spec.VolumeClaimTemplates[i] = corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: t.Name}}
+		}
+	case *appsv1.Deployment:
+		stripMeta(&w.ObjectMeta)
+		w.Spec.Template = corev1.PodTemplateSpec{}
+	}
+	return in, nil
+}
+
+// stripMeta drops managedFields and the last-applied-configuration annotation.
+func stripMeta(m *metav1.ObjectMeta) {
+	m.ManagedFields = nil
+	delete(m.Annotations, corev1.LastAppliedConfigAnnotation)
+}
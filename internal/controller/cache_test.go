@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("lean cache transforms", func() {
+	fatMeta := func(name string, lbls map[string]string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:          name,
+			Namespace:     "vcluster",
+			Labels:        lbls,
+			Annotations:   map[string]string{corev1.LastAppliedConfigAnnotation: "{...}", "keep": "me"},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		}
+	}
+	fatSpec := corev1.PodSpec{
+		Volumes: []corev1.Volume{{Name: "data"}},
+		Containers: []corev1.Container{{
+			Name:         "syncer",
+			Image:        "ghcr.io/loft-sh/vcluster:0.20.0",
+			Args:         []string{"--name=vc-prod"},
+			Env:          []corev1.EnvVar{{Name: "SECRET", Value: "x"}},
+			VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+		}},
+	}
+	readyStatus := corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+
+	It("drops container specs and volumes from synced pods but keeps labels and status", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: fatMeta("nginx-x-default-x-vc-prod", map[string]string{"vcluster.loft.sh/managed-by": "vc-prod"}),
+			Spec:       *fatSpec.DeepCopy(),
+			Status:     readyStatus,
+		}
+		out, err := transformPod(pod)
+		Expect(err).NotTo(HaveOccurred())
+
+		slim := out.(*corev1.Pod)
+		Expect(slim.ManagedFields).To(BeNil())
+		Expect(slim.Annotations).To(Equal(map[string]string{"keep": "me"}))
+		Expect(slim.Spec.Volumes).To(BeNil())
+		Expect(slim.Spec.Containers).To(BeNil())
+		Expect(slim.Labels).To(HaveKeyWithValue("vcluster.loft.sh/managed-by", "vc-prod"))
+		Expect(slim.Status).To(Equal(readyStatus))
+	})
+
+	It("keeps image and args on pods that were not synced", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: fatMeta("vc-prod-0", map[string]string{"app": "vcluster"}),
+			Spec:       *fatSpec.DeepCopy(),
+		}
+		out, err := transformPod(pod)
+		Expect(err).NotTo(HaveOccurred())
+
+		c := out.(*corev1.Pod).Spec.Containers
+		Expect(c).To(HaveLen(1))
+		Expect(c[0].Image).To(Equal("ghcr.io/loft-sh/vcluster:0.20.0"))
+		Expect(c[0].Args).To(Equal([]string{"--name=vc-prod"}))
+		Expect(c[0].Env).To(BeNil())
+		Expect(c[0].VolumeMounts).To(BeNil())
+
+		// A control-plane pod found by a custom selector has neither app=vcluster nor an owner label.
+		pod = &corev1.Pod{ObjectMeta: fatMeta("api-0", map[string]string{"component": "api"}), Spec: *fatSpec.DeepCopy()}
+		out, err = transformPod(pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.(*corev1.Pod).Spec.Containers).To(HaveLen(1))
+	})
+
+	It("keeps only the replica count of control-plane workloads", func() {
+		sts := &appsv1.StatefulSet{
+			ObjectMeta: fatMeta("vc-prod", nil),
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:             ptr.To[int32](3),
+				Template:             corev1.PodTemplateSpec{Spec: *fatSpec.DeepCopy()},
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+			},
+		}
+		out, err := transformWorkload(sts)
+		Expect(err).NotTo(HaveOccurred())
+
+		slim := out.(*appsv1.StatefulSet)
+		Expect(slim.ManagedFields).To(BeNil())
+		Expect(slim.Spec.Template.Spec.Containers).To(BeNil())
+		Expect(slim.Spec.VolumeClaimTemplates).To(Equal([]corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}}))
+		Expect(*slim.Spec.Replicas).To(Equal(int32(3)))
+	})
+
+	It("passes through objects of other types", func() {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "x"}}
+		out, err := transformPod(ns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(BeIdenticalTo(ns))
+	})
+
+	It("keeps detectors working on slimmed objects", func() {
+		cp := &corev1.Pod{ObjectMeta: fatMeta("vc-prod-0", map[string]string{"app": "vcluster"}), Spec: *fatSpec.DeepCopy(), Status: readyStatus}
+		wl := &corev1.Pod{
+			ObjectMeta: fatMeta("nginx-x-default-x-vc-prod", map[string]string{
+				"vcluster.loft.sh/managed-by": "vc-prod",
+				"vcluster.loft.sh/namespace":  "default",
+			}),
+			Spec:   *fatSpec.DeepCopy(),
+			Status: readyStatus,
+		}
+		dns := &corev1.Service{ObjectMeta: fatMeta("kube-dns-x-kube-system-x-vc-prod", nil)}
+		for _, o := range []any{cp, wl} {
+			_, err := transformPod(o)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := transformService(dns)
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+		signals := DefaultDetectorRegistry().Evaluate(context.Background(), cluster, newTestSnapshot(cp, wl, dns))
+		Expect(signalTrue(signals, SignalControlPlaneReady)).To(BeTrue())
+		Expect(signalTrue(signals, SignalDNSSync)).To(BeTrue())
+		Expect(signalTrue(signals, SignalTenantWorkloadSync)).To(BeTrue())
+	})
+
+	It("only sets transforms when lean and applies the Pod selector", func() {
+		sel := labels.SelectorFromSet(labels.Set{"tier": "vcluster"})
+		opts := HostCacheOptions(true, sel)
+		Expect(opts.ByObject).To(HaveLen(6))
+		for obj, bo := range opts.ByObject {
+			Expect(bo.Transform).NotTo(BeNil())
+			if _, ok := obj.(*corev1.Pod); ok {
+				Expect(bo.Label).To(Equal(sel))
+			}
+		}
+
+		for _, bo := range HostCacheOptions(false, nil).ByObject {
+			Expect(bo.Transform).To(BeNil())
+			Expect(bo.Label).To(BeNil())
+		}
+	})
+})
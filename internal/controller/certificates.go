@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalCertificatesValid reports whether every certificate of a vCluster parses and has not
+// expired; within the warning window it stays true with reason ExpiringSoon. It is registered
+// only when spec.certificates is set.
+const SignalCertificatesValid = "certificatesValid"
+
+const (
+	// DefaultCertificateWarningDays is how long before expiry certificatesValid reads ExpiringSoon.
+	DefaultCertificateWarningDays = 30
+	// certsSecretSuffix names the Secret holding the vCluster's CA and serving certificates.
+	certsSecretSuffix = "-certs"
+)
+
+// certificatePolicy is the resolved spec.certificates.
+type certificatePolicy struct {
+	warningWindow time.Duration
+	// kubeconfigPrefix names the kubeconfig Secret, as for the probe.
+	kubeconfigPrefix string
+}
+
+// certificatePolicyFor returns the certificate check from spec.certificates, or nil if it is not set.
+func certificatePolicyFor(spec fleetv1alpha1.VClusterHealthSpec) *certificatePolicy {
+	if spec.Certificates == nil {
+		return nil
+	}
+	p := &certificatePolicy{
+		warningWindow:    DefaultCertificateWarningDays * 24 * time.Hour,
+		kubeconfigPrefix: defaultKubeconfigSecretPrefix,
+	}
+	if d := spec.Certificates.WarningWindowDays; d != nil {
+		p.warningWindow = time.Duration(*d) * 24 * time.Hour
+	}
+	if spec.Probe != nil && spec.Probe.KubeconfigSecretPrefix != nil {
+		p.kubeconfigPrefix = *spec.Probe.KubeconfigSecretPrefix
+	}
+	return p
+}
+
+// secretNames returns the Secrets checked for c.
+func (p *certificatePolicy) secretNames(c fleetv1alpha1.DiscoveredCluster) []string {
+	return []string{c.Name + certsSecretSuffix, p.kubeconfigPrefix + c.Name}
+}
+
+// CertificateState is the certificate material found for one vCluster.
+type CertificateState struct {
+	// Secrets are the Secrets that existed and were inspected.
+	Secrets []string
+	// Checked counts the parsed certificates.
+	Checked int
+	// Earliest is the certificate with the earliest notAfter, or nil if none was found.
+	Earliest *x509.Certificate
+	// Source is the Secret and key holding Earliest, e.g. vc-prod-certs/apiserver.crt.
+	Source string
+	// Invalid describes the first certificate that could not be parsed.
+	Invalid string
+}
+
+// Status returns the state as reported in SyncCoverage.
+func (s *CertificateState) Status() *fleetv1alpha1.CertificatesStatus {
+	st := &fleetv1alpha1.CertificatesStatus{Checked: int32(s.Checked)}
+	if s.Earliest != nil {
+		notAfter := metav1.NewTime(s.Earliest.NotAfter)
+		st.NotAfter = &notAfter
+		st.Subject = s.Earliest.Subject.String()
+		st.Source = s.Source
+	}
+	return st
+}
+
+// add records the certificates PEM-encoded in data, found at source.
+func (s *CertificateState) add(source string, data []byte) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			if s.Invalid == "" {
+				s.Invalid = fmt.Sprintf("%s: %v", source, err)
+			}
+			continue
+		}
+		s.Checked++
+		if s.Earliest == nil || cert.NotAfter.Before(s.Earliest.NotAfter) {
+			s.Earliest, s.Source = cert, source
+		}
+	}
+}
+
+// addKubeconfig records the CA and client certificates embedded in a kubeconfig.
+func (s *CertificateState) addKubeconfig(source string, data []byte) {
+	cfg, err := clientcmd.Load(data)
+	if err != nil {
+		if s.Invalid == "" {
+			s.Invalid = fmt.Sprintf("%s: %v", source, err)
+		}
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(cfg.Clusters)) {
+		s.add(source, cfg.Clusters[name].CertificateAuthorityData)
+	}
+	for _, name := range slices.Sorted(maps.Keys(cfg.AuthInfos)) {
+		s.add(source, cfg.AuthInfos[name].ClientCertificateData)
+	}
+}
+
+// Certificates parses the certificates in c's <name>-certs and kubeconfig Secrets, read
+// uncached through apiReader. It returns nil if spec.certificates is not set; missing
+// Secrets are skipped. The result is memoised.
+func (s *HostSnapshot) Certificates(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*CertificateState, error) {
+	if s.certificates == nil {
+		return nil, nil
+	}
+	key := c.Namespace + "/" + c.Name
+	if st, ok := s.certs[key]; ok {
+		return st, nil
+	}
+	reader := s.uncached()
+
+	st := &CertificateState{}
+	kubeconfigSecret := s.certificates.kubeconfigPrefix + c.Name
+	for _, name := range s.certificates.secretNames(c) {
+		var secret corev1.Secret
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: c.Namespace, Name: name}, &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("reading certificate Secret %s/%s: %w", c.Namespace, name, err)
+		}
+		st.Secrets = append(st.Secrets, name)
+		for _, k := range slices.Sorted(maps.Keys(secret.Data)) {
+			source := name + "/" + k
+			if name == kubeconfigSecret && k == kubeconfigSecretKey {
+				st.addKubeconfig(source, secret.Data[k])
+				continue
+			}
+			st.add(source, secret.Data[k])
+		}
+	}
+	s.certs[key] = st
+	return st, nil
+}
+
+// certificatesDetector reports whether the certificates of a vCluster are valid, and whether the
+// earliest one expires within the warning window.
+type certificatesDetector struct{}
+
+func (certificatesDetector) Name() string { return SignalCertificatesValid }
+
+func (certificatesDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	st, err := snap.Certificates(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if st == nil {
+		return checkDisabled("spec.certificates is not set")
+	}
+	// An expired certificate is the more urgent finding, so it is reported before an invalid one.
+	now := time.Now()
+	if st.Earliest != nil && !now.Before(st.Earliest.NotAfter) {
+		evidence := fmt.Sprintf("%s (%s) expired at %s", st.Earliest.Subject, st.Source, st.Earliest.NotAfter.UTC().Format(time.RFC3339))
+		if st.Invalid != "" {
+			evidence += "; invalid " + st.Invalid
+		}
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "Expired", Evidence: evidence}
+	}
+	if st.Invalid != "" {
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "InvalidCertificate", Evidence: st.Invalid}
+	}
+	if st.Earliest == nil {
+		secrets := strings.Join(snap.certificates.secretNames(c), ", ")
+		return DetectorResult{Status: metav1.ConditionUnknown, Reason: "CertificatesNotFound", Evidence: "no certificates in Secrets " + secrets}
+	}
+
+	notAfter := st.Earliest.NotAfter
+	subject := fmt.Sprintf("%s (%s)", st.Earliest.Subject, st.Source)
+	if notAfter.Sub(now) < snap.certificates.warningWindow {
+		// Still valid: the signal stays True and the reason raises a Warning event instead.
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "ExpiringSoon",
+			Evidence: fmt.Sprintf("%s expires at %s", subject, notAfter.UTC().Format(time.RFC3339)),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionTrue,
+		Reason:   "Valid",
+		Evidence: fmt.Sprintf("%d certificates valid, earliest %s expires at %s", st.Checked, subject, notAfter.UTC().Format(time.RFC3339)),
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// Condition reasons for Ready, Degraded and Stalled.
+const (
+	ReasonListServicesFailed          = "ListServicesFailed"
+	ReasonListPodsFailed              = "ListPodsFailed"
+	ReasonListNamespacesFailed        = "ListNamespacesFailed"
+	ReasonListEndpointSlicesFailed    = "ListEndpointSlicesFailed"
+	ReasonGetWorkloadFailed           = "GetWorkloadFailed"
+	ReasonGetVolumeClaimFailed        = "GetVolumeClaimFailed"
+	ReasonInvalidDiscoverySelector    = "InvalidDiscoverySelector"
+	ReasonInvalidNamespaceSelection   = "InvalidNamespaceSelection"
+	ReasonInvalidControlPlaneSelector = "InvalidControlPlaneSelector"
+	ReasonInvalidVersionPolicy        = "InvalidVersionPolicy"
+	ReasonAllClustersFull             = "AllClustersFull"
+	ReasonClustersDegraded            = "ClustersDegraded"
+	ReasonNoClustersDiscovered        = "NoClustersDiscovered"
+	ReasonReconcileSucceeded          = "ReconcileSucceeded"
+)
+
+// signalConditionType converts a signal name to its condition type (controlPlaneReady -> ControlPlaneReady).
+func signalConditionType(signal string) string {
+	if signal == "" {
+		return signal
+	}
+	return strings.ToUpper(signal[:1]) + signal[1:]
+}
+
+// signalConditions returns one condition per signal. Conditions from prev keep their
+// lastTransitionTime while the status is unchanged; conditions for signals no longer
+// reported are dropped.
+func signalConditions(prev []metav1.Condition, signals []fleetv1alpha1.SignalResult, generation int64) []metav1.Condition {
+	conds := make([]metav1.Condition, 0, len(signals))
+	for _, s := range signals {
+		t := signalConditionType(s.Name)
+		if old := meta.FindStatusCondition(prev, t); old != nil {
+			conds = append(conds, *old)
+		}
+		reason := s.Reason
+		if reason == "" {
+			reason = defaultSignalReason(s.Status)
+		}
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:               t,
+			Status:             s.Status,
+			Reason:             reason,
+			Message:            s.Evidence,
+			ObservedGeneration: generation,
+		})
+	}
+	return conds
+}
+
+// defaultSignalReason is used for detectors that report no reason.
+func defaultSignalReason(status metav1.ConditionStatus) string {
+	switch status {
+	case metav1.ConditionTrue:
+		return "Observed"
+	case metav1.ConditionFalse:
+		return "NotObserved"
+	default:
+		return "Unknown"
+	}
+}
+
+// topLevel is the level a fully healthy vCluster reaches under the given policy.
+func topLevel(policy *fleetv1alpha1.ScoringPolicy) string {
+	if policy == nil || len(policy.Levels) == 0 {
+		return "Full"
+	}
+	top := policy.Levels[0]
+	for _, l := range policy.Levels[1:] {
+		if l.MinScore > top.MinScore {
+			top = l
+		}
+	}
+	return top.Name
+}
+
+// setHealthConditions sets Ready, Degraded and Stalled after a successful reconcile.
+func setHealthConditions(vh *fleetv1alpha1.VClusterHealth, coverage []fleetv1alpha1.SyncCoverage) {
+	gen := vh.Generation
+	top := topLevel(vh.Spec.Scoring)
+
+	var degraded []string
+	for _, c := range coverage {
+		if c.Level != top {
+			degraded = append(degraded, fmt.Sprintf("%s/%s=%s", c.Namespace, c.ClusterName, c.Level))
+		}
+	}
+	sort.Strings(degraded)
+
+	meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+		Type:               fleetv1alpha1.ConditionStalled,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonReconcileSucceeded,
+		Message:            "host cluster observed",
+		ObservedGeneration: gen,
+	})
+
+	switch {
+	case len(degraded) > 0:
+		msg := fmt.Sprintf("%d/%d clusters below %s: %s", len(degraded), len(coverage), top, strings.Join(degraded, ", "))
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             ReasonClustersDegraded,
+			Message:            msg,
+			ObservedGeneration: gen,
+		})
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionDegraded,
+			Status:             metav1.ConditionTrue,
+			Reason:             ReasonClustersDegraded,
+			Message:            msg,
+			ObservedGeneration: gen,
+		})
+	case len(coverage) == 0:
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             ReasonNoClustersDiscovered,
+			Message:            "no vClusters discovered",
+			ObservedGeneration: gen,
+		})
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionDegraded,
+			Status:             metav1.ConditionFalse,
+			Reason:             ReasonNoClustersDiscovered,
+			Message:            "no vClusters discovered",
+			ObservedGeneration: gen,
+		})
+	default:
+		msg := fmt.Sprintf("all %d clusters at %s", len(coverage), top)
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             ReasonAllClustersFull,
+			Message:            msg,
+			ObservedGeneration: gen,
+		})
+		meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+			Type:               fleetv1alpha1.ConditionDegraded,
+			Status:             metav1.ConditionFalse,
+			Reason:             ReasonAllClustersFull,
+			Message:            msg,
+			ObservedGeneration: gen,
+		})
+	}
+}
+
+// setStalledConditions marks the fleet as Stalled and not Ready because the host could not be observed.
+// Degraded is left untouched: the last known cluster health is still the best information available.
+func setStalledConditions(vh *fleetv1alpha1.VClusterHealth, reason string, err error) {
+	gen := vh.Generation
+	meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+		Type:               fleetv1alpha1.ConditionStalled,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            err.Error(),
+		ObservedGeneration: gen,
+	})
+	meta.SetStatusCondition(&vh.Status.Conditions, metav1.Condition{
+		Type:               fleetv1alpha1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            err.Error(),
+		ObservedGeneration: gen,
+	})
+}
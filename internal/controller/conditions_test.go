@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// newFakeScheme returns a scheme with core and fleet types registered.
+func newFakeScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+	Expect(fleetv1alpha1.AddToScheme(s)).To(Succeed())
+	return s
+}
+
+var _ = Describe("per-signal conditions", func() {
+	earlier := metav1.NewTime(metav1.Now().Add(-time.Hour))
+
+	It("creates one condition per signal with capitalised types", func() {
+		conds := signalConditions(nil, []fleetv1alpha1.SignalResult{
+			{Name: SignalDNSSync, Status: metav1.ConditionFalse, Reason: "ServiceNotFound", Evidence: "no Service"},
+			{Name: "custom", Status: metav1.ConditionTrue},
+		}, 1)
+
+		Expect(conds).To(HaveLen(2))
+		Expect(conds[0].Type).To(Equal("DnsSync"))
+		Expect(conds[0].Reason).To(Equal("ServiceNotFound"))
+		Expect(conds[0].Message).To(Equal("no Service"))
+		Expect(conds[0].LastTransitionTime.IsZero()).To(BeFalse())
+		Expect(conds[1].Type).To(Equal("Custom"))
+		Expect(conds[1].Reason).To(Equal("Observed"))
+	})
+
+	It("keeps lastTransitionTime while the status is unchanged", func() {
+		prev := []metav1.Condition{
+			{Type: "DnsSync", Status: metav1.ConditionFalse, Reason: "ServiceNotFound", LastTransitionTime: earlier},
+			{Type: "NodeSync", Status: metav1.ConditionFalse, Reason: "ServiceNotFound", LastTransitionTime: earlier},
+			{Type: "Removed", Status: metav1.ConditionTrue, Reason: "Observed", LastTransitionTime: earlier},
+		}
+		conds := signalConditions(prev, []fleetv1alpha1.SignalResult{
+			{Name: SignalDNSSync, Status: metav1.ConditionFalse, Reason: "ServiceNotFound", Evidence: "still missing"},
+			{Name: SignalNodeSync, Status: metav1.ConditionTrue, Reason: "ServiceFound"},
+		}, 2)
+
+		Expect(conds).To(HaveLen(2))
+		dns := meta.FindStatusCondition(conds, "DnsSync")
+		Expect(dns.LastTransitionTime).To(Equal(earlier))
+		Expect(dns.Message).To(Equal("still missing"))
+		node := meta.FindStatusCondition(conds, "NodeSync")
+		Expect(node.Status).To(Equal(metav1.ConditionTrue))
+		Expect(node.LastTransitionTime.After(earlier.Time)).To(BeTrue())
+		Expect(meta.FindStatusCondition(conds, "Removed")).To(BeNil())
+	})
+})
+
+var _ = Describe("fleet conditions", func() {
+	It("is Ready with AllClustersFull when every cluster is Full", func() {
+		vh := &fleetv1alpha1.VClusterHealth{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		setHealthConditions(vh, []fleetv1alpha1.SyncCoverage{{ClusterName: "vc-a", Level: "Full"}})
+
+		ready := meta.FindStatusCondition(vh.Status.Conditions, fleetv1alpha1.ConditionReady)
+		Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+		Expect(ready.Reason).To(Equal(ReasonAllClustersFull))
+		Expect(ready.ObservedGeneration).To(Equal(int64(2)))
+		Expect(meta.IsStatusConditionFalse(vh.Status.Conditions, fleetv1alpha1.ConditionDegraded)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(vh.Status.Conditions, fleetv1alpha1.ConditionStalled)).To(BeTrue())
+	})
+
+	It("is Degraded when a cluster is below the top custom level", func() {
+		vh := &fleetv1alpha1.VClusterHealth{Spec: fleetv1alpha1.VClusterHealthSpec{
+			Scoring: &fleetv1alpha1.ScoringPolicy{Levels: []fleetv1alpha1.LevelThreshold{
+				{Name: "Degraded", MinScore: 50},
+				{Name: "Healthy", MinScore: 90},
+			}},
+		}}
+		setHealthConditions(vh, []fleetv1alpha1.SyncCoverage{
+			{ClusterName: "vc-b", Namespace: "team-a", Level: "Degraded"},
+			{ClusterName: "vc-b", Namespace: "team-b", Level: "Healthy"},
+			{ClusterName: "vc-a", Namespace: "team-a", Level: "Healthy"},
+		})
+
+		degraded := meta.FindStatusCondition(vh.Status.Conditions, fleetv1alpha1.ConditionDegraded)
+		Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+		Expect(degraded.Reason).To(Equal(ReasonClustersDegraded))
+		Expect(degraded.Message).To(Equal("1/3 clusters below Healthy: team-a/vc-b=Degraded"))
+		Expect(meta.IsStatusConditionFalse(vh.Status.Conditions, fleetv1alpha1.ConditionReady)).To(BeTrue())
+	})
+
+	It("marks the fleet Stalled and returns the error when listing Services fails", func() {
+		vh := &fleetv1alpha1.VClusterHealth{ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"}}
+		listErr := errors.New("boom")
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(vh).
+			WithStatusSubresource(vh).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*corev1.ServiceList); ok {
+						return listErr
+					}
+					return cl.List(ctx, list, opts...)
+				},
+				SubResourceApply: fakeStatusApply,
+			}).
+			Build()
+
+		r := &VClusterHealthReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}})
+		Expect(err).To(MatchError(listErr))
+
+		var got fleetv1alpha1.VClusterHealth
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(vh), &got)).To(Succeed())
+		stalled := meta.FindStatusCondition(got.Status.Conditions, fleetv1alpha1.ConditionStalled)
+		Expect(stalled).NotTo(BeNil())
+		Expect(stalled.Status).To(Equal(metav1.ConditionTrue))
+		Expect(stalled.Reason).To(Equal(ReasonListServicesFailed))
+		Expect(meta.IsStatusConditionFalse(got.Status.Conditions, fleetv1alpha1.ConditionReady)).To(BeTrue())
+	})
+})
@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// labelRelease is the label the vCluster chart sets to the vCluster name on control-plane pods.
+const labelRelease = "release"
+
+// controlPlaneSelector identifies the control-plane pods of a vCluster: pods in its namespace
+// that match pods and whose clusterLabel equals the vCluster name.
+type controlPlaneSelector struct {
+	pods         labels.Selector
+	clusterLabel string
+}
+
+// defaultControlPlaneSelector matches the pods of the vCluster chart (app=vcluster, release=<name>).
+var defaultControlPlaneSelector = controlPlaneSelector{
+	pods:         labels.SelectorFromSet(labels.Set{"app": "vcluster"}),
+	clusterLabel: labelRelease,
+}
+
+// controlPlaneSelectorFor returns the control-plane selector from spec.controlPlane.
+func controlPlaneSelectorFor(spec fleetv1alpha1.VClusterHealthSpec) (controlPlaneSelector, error) {
+	sel := defaultControlPlaneSelector
+	cp := spec.ControlPlane
+	if cp == nil {
+		return sel, nil
+	}
+	if cp.PodSelector != nil {
+		pods, err := metav1.LabelSelectorAsSelector(cp.PodSelector)
+		if err != nil {
+			return sel, fmt.Errorf("spec.controlPlane.podSelector: %w", err)
+		}
+		sel.pods = pods
+	}
+	if cp.ClusterLabel != "" {
+		sel.clusterLabel = cp.ClusterLabel
+	}
+	return sel, nil
+}
+
+// forCluster returns the pod selector for one vCluster.
+func (s controlPlaneSelector) forCluster(name string) (labels.Selector, error) {
+	req, err := labels.NewRequirement(s.clusterLabel, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	return s.pods.Add(*req), nil
+}
+
+// ControlPlaneState is the resolved control plane of one vCluster.
+type ControlPlaneState struct {
+	// Kind and Name identify the owning workload. Kind is "Pod" when no owner was resolved.
+	Kind string
+	Name string
+	// Desired is the workload's replica count, or len(Pods) without an owner.
+	Desired int32
+	// Ready counts the Pods that are Running and Ready.
+	Ready int32
+	// Pods are the control-plane pods.
+	Pods []corev1.Pod
+	// RecentRestarts and Issues describe container stability within the restart window.
+	RecentRestarts int32
+	Issues         []fleetv1alpha1.ContainerIssue
+}
+
+// Status returns the state as reported in SyncCoverage.
+func (s *ControlPlaneState) Status() *fleetv1alpha1.ControlPlaneStatus {
+	return &fleetv1alpha1.ControlPlaneStatus{
+		Kind:            s.Kind,
+		Name:            s.Name,
+		ReadyReplicas:   s.Ready,
+		DesiredReplicas: s.Desired,
+		RecentRestarts:  s.RecentRestarts,
+		Issues:          s.Issues,
+	}
+}
+
+// ControlPlane resolves the control plane of c: its pods (by selector, falling back to the
+// pod <name>-0), the StatefulSet or Deployment owning them, and container stability. The
+// result is memoised.
+func (s *HostSnapshot) ControlPlane(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*ControlPlaneState, error) {
+	key := c.Namespace + "/" + c.Name
+	if st, ok := s.controlPlanes[key]; ok {
+		return st, nil
+	}
+
+	sel, err := s.controlPlaneSelector.forCluster(c.Name)
+	if err != nil {
+		return nil, err
+	}
+	opts := []client.ListOption{client.InNamespace(c.Namespace), client.MatchingLabelsSelector{Selector: sel}}
+	if s.controlPlaneSelector.clusterLabel == labelRelease {
+		opts = append(opts, client.MatchingFields{indexPodRelease: c.Name})
+	}
+	var list corev1.PodList
+	if err := s.reader.List(ctx, &list, opts...); err != nil {
+		s.recordPodErr(err)
+		return nil, err
+	}
+	pods := list.Items
+	if len(pods) == 0 {
+		// Older charts do not set the cluster label; fall back to the StatefulSet pod name.
+		pod, err := s.Pod(ctx, c.Namespace, c.Name+"-0")
+		if err != nil {
+			return nil, err
+		}
+		if pod != nil {
+			pods = []corev1.Pod{*pod}
+		}
+	}
+
+	st := &ControlPlaneState{Kind: "Pod", Desired: int32(len(pods)), Pods: pods}
+	for i := range pods {
+		if pods[i].DeletionTimestamp.IsZero() && pods[i].Status.Phase == corev1.PodRunning && podReady(&pods[i]) {
+			st.Ready++
+		}
+	}
+	st.Issues, st.RecentRestarts = s.stability.inspect(pods, time.Now())
+	if err := s.resolveControlPlaneOwner(ctx, c, st); err != nil {
+		s.recordWorkloadErr(err)
+		return nil, err
+	}
+	s.controlPlanes[key] = st
+	return st, nil
+}
+
+// resolveControlPlaneOwner fills in the owning workload from the pods' controller references.
+// Without pods, a StatefulSet or Deployment named after the vCluster is used, so a full
+// outage still reports the desired replica count.
+func (s *HostSnapshot) resolveControlPlaneOwner(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, st *ControlPlaneState) error {
+	for i := range st.Pods {
+		ref := metav1.GetControllerOf(&st.Pods[i])
+		if ref == nil {
+			continue
+		}
+		switch ref.Kind {
+		case "StatefulSet":
+			found, err := s.useStatefulSet(ctx, c.Namespace, ref.Name, st)
+			if found || err != nil {
+				return err
+			}
+		case "ReplicaSet":
+			rs := &metav1.PartialObjectMetadata{}
+			rs.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))
+			if err := s.reader.Get(ctx, client.ObjectKey{Namespace: c.Namespace, Name: ref.Name}, rs); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			dref := metav1.GetControllerOf(rs)
+			if dref == nil || dref.Kind != "Deployment" {
+				continue
+			}
+			found, err := s.useDeployment(ctx, c.Namespace, dref.Name, st)
+			if found || err != nil {
+				return err
+			}
+		}
+	}
+	if len(st.Pods) > 0 {
+		return nil
+	}
+	if found, err := s.useStatefulSet(ctx, c.Namespace, c.Name, st); found || err != nil {
+		return err
+	}
+	_, err := s.useDeployment(ctx, c.Namespace, c.Name, st)
+	return err
+}
+
+func (s *HostSnapshot) useStatefulSet(ctx context.Context, namespace, name string, st *ControlPlaneState) (bool, error) {
+	var sts appsv1.StatefulSet
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &sts); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	st.Kind, st.Name, st.Desired = "StatefulSet", sts.Name, replicasOrDefault(sts.Spec.Replicas)
+	return true, nil
+}
+
+func (s *HostSnapshot) useDeployment(ctx context.Context, namespace, name string, st *ControlPlaneState) (bool, error) {
+	var d appsv1.Deployment
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &d); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	st.Kind, st.Name, st.Desired = "Deployment", d.Name, replicasOrDefault(d.Spec.Replicas)
+	return true, nil
+}
+
+// replicasOrDefault returns *replicas, or 1 (the API default) if unset.
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
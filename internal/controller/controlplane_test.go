@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("control-plane resolution", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+	cpLabels := map[string]string{"app": "vcluster", "release": "vc-prod"}
+
+	cpPod := func(name string, ready bool, owner metav1.OwnerReference) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster", Labels: cpLabels, OwnerReferences: []metav1.OwnerReference{owner}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ready {
+			p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return p
+	}
+	controllerRef := func(kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: "apps/v1", Kind: kind, Name: name, Controller: ptr.To(true)}
+	}
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "vc-prod", Namespace: "vcluster"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+	}
+
+	It("reports a partial HA outage from the owning StatefulSet", func() {
+		snap := newTestSnapshot(statefulSet,
+			cpPod("vc-prod-0", true, controllerRef("StatefulSet", "vc-prod")),
+			cpPod("vc-prod-1", true, controllerRef("StatefulSet", "vc-prod")),
+			cpPod("vc-prod-2", false, controllerRef("StatefulSet", "vc-prod")),
+		)
+		cp, err := snap.ControlPlane(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Status()).To(Equal(&fleetv1alpha1.ControlPlaneStatus{Kind: "StatefulSet", Name: "vc-prod", ReadyReplicas: 2, DesiredReplicas: 3}))
+
+		res := controlPlaneDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("PartiallyReady"))
+		Expect(res.Evidence).To(Equal("StatefulSet vc-prod: 2/3 replicas ready"))
+	})
+
+	It("follows ReplicaSets to the owning Deployment", func() {
+		rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "vc-prod-5d8f7", Namespace: "vcluster",
+			OwnerReferences: []metav1.OwnerReference{controllerRef("Deployment", "vc-prod")},
+		}}
+		deploy := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod", Namespace: "vcluster"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		}
+		objs := []client.Object{rs, deploy}
+		for i := range 2 {
+			objs = append(objs, cpPod(fmt.Sprintf("vc-prod-5d8f7-%d", i), true, controllerRef("ReplicaSet", rs.Name)))
+		}
+
+		res := controlPlaneDetector{}.Evaluate(ctx, cluster, newTestSnapshot(objs...))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("ReplicasReady"))
+		Expect(res.Evidence).To(Equal("Deployment vc-prod: 2/2 replicas ready"))
+	})
+
+	It("reports the desired count of a workload without pods", func() {
+		cp, err := newTestSnapshot(statefulSet).ControlPlane(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Status()).To(Equal(&fleetv1alpha1.ControlPlaneStatus{Kind: "StatefulSet", Name: "vc-prod", ReadyReplicas: 0, DesiredReplicas: 3}))
+		Expect(controlPlaneDetector{}.Evaluate(ctx, cluster, newTestSnapshot(statefulSet)).Reason).To(Equal("PodNotFound"))
+	})
+
+	It("falls back to the <name>-0 pod and honours a custom selector", func() {
+		legacy := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+		}
+		cp, err := newTestSnapshot(legacy).ControlPlane(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Status()).To(Equal(&fleetv1alpha1.ControlPlaneStatus{Kind: "Pod", ReadyReplicas: 1, DesiredReplicas: 1}))
+
+		sel, err := controlPlaneSelectorFor(fleetv1alpha1.VClusterHealthSpec{ControlPlane: &fleetv1alpha1.ControlPlaneSpec{
+			PodSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"component": "api"}},
+			ClusterLabel: "vcluster.example.com/name",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		custom := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-abc", Namespace: "vcluster", Labels: map[string]string{"component": "api", "vcluster.example.com/name": "vc-prod"}},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		}
+		snap := newTestSnapshot(custom, legacy)
+		snap.controlPlaneSelector = sel
+		cp, err = snap.ControlPlane(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Pods).To(HaveLen(1))
+		Expect(cp.Pods[0].Name).To(Equal("api-abc"))
+		Expect(cp.Ready).To(BeZero())
+	})
+
+	It("rejects an invalid control-plane selector", func() {
+		_, err := controlPlaneSelectorFor(fleetv1alpha1.VClusterHealthSpec{ControlPlane: &fleetv1alpha1.ControlPlaneSpec{
+			PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"bad key!": "x"}},
+		}})
+		Expect(err).To(MatchError(ContainSubstring("spec.controlPlane.podSelector")))
+	})
+})
@@ -0,0 +1,335 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// Built-in signal names. They match the json names of the SyncCoverage booleans.
+const (
+	SignalAPISync            = "apiSync"
+	SignalControlPlaneReady  = "controlPlaneReady"
+	SignalDNSSync            = "dnsSync"
+	SignalNodeSync           = "nodeSync"
+	SignalSystemWorkloadSync = "systemWorkloadSync"
+	SignalTenantWorkloadSync = "tenantWorkloadSync"
+)
+
+// DetectorResult is what a Detector reports for a single vCluster.
+type DetectorResult struct {
+	// Status is True when the signal is present, False when absent, Unknown when it could not be evaluated.
+	Status metav1.ConditionStatus
+	// Reason is a CamelCase token explaining the status.
+	Reason string
+	// Evidence describes the host object(s) that led to the status.
+	Evidence string
+}
+
+// Detector evaluates one health signal for a discovered vCluster.
+type Detector interface {
+	// Name is the signal name. It must be unique within a registry.
+	Name() string
+	// Evaluate inspects the snapshot and reports the signal for the given cluster.
+	Evaluate(ctx context.Context, cluster fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult
+}
+
+// DetectorRegistry is an ordered set of detectors evaluated by the reconciler.
+type DetectorRegistry struct {
+	detectors []Detector
+	names     map[string]struct{}
+}
+
+// NewDetectorRegistry returns a registry containing the given detectors.
+// It panics on duplicate names, since that is a programming error.
+func NewDetectorRegistry(detectors ...Detector) *DetectorRegistry {
+	r := &DetectorRegistry{names: map[string]struct{}{}}
+	for _, d := range detectors {
+		if err := r.Register(d); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// DefaultDetectorRegistry returns a registry with the built-in host-side detectors.
+func DefaultDetectorRegistry() *DetectorRegistry {
+	return NewDetectorRegistry(
+		apiSyncDetector{},
+		controlPlaneDetector{},
+		controlPlaneStableDetector{},
+		backingStoreDetector{},
+		releaseDetector{},
+		dnsSyncDetector{},
+		nodeSyncDetector{},
+		workloadSyncDetector{system: true},
+		workloadSyncDetector{system: false},
+	)
+}
+
+// Register appends a detector. Detector names must be unique.
+func (r *DetectorRegistry) Register(d Detector) error {
+	if r.names == nil {
+		r.names = map[string]struct{}{}
+	}
+	if _, ok := r.names[d.Name()]; ok {
+		return fmt.Errorf("detector %q already registered", d.Name())
+	}
+	r.names[d.Name()] = struct{}{}
+	r.detectors = append(r.detectors, d)
+	return nil
+}
+
+// Detectors returns the registered detectors in registration order.
+func (r *DetectorRegistry) Detectors() []Detector {
+	return r.detectors
+}
+
+// with returns a registry that also holds d, unless a detector of that name is already
+// registered. The receiver is not modified.
+func (r *DetectorRegistry) with(d Detector) *DetectorRegistry {
+	if _, ok := r.names[d.Name()]; ok {
+		return r
+	}
+	return NewDetectorRegistry(append(slices.Clone(r.detectors), d)...)
+}
+
+// Evaluate runs every registered detector against the cluster and returns one SignalResult per detector.
+func (r *DetectorRegistry) Evaluate(ctx context.Context, cluster fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) []fleetv1alpha1.SignalResult {
+	results := make([]fleetv1alpha1.SignalResult, 0, len(r.detectors))
+	for _, d := range r.detectors {
+		res := d.Evaluate(ctx, cluster, snap)
+		results = append(results, fleetv1alpha1.SignalResult{
+			Name:     d.Name(),
+			Status:   res.Status,
+			Reason:   res.Reason,
+			Evidence: res.Evidence,
+		})
+	}
+	return results
+}
+
+// signalTrue reports whether the named signal is present with status True.
+func signalTrue(results []fleetv1alpha1.SignalResult, name string) bool {
+	for _, r := range results {
+		if r.Name == name {
+			return r.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// signalDisabled reports whether the named result is from a check that is turned off.
+func signalDisabled(results []fleetv1alpha1.SignalResult, name string) bool {
+	for _, r := range results {
+		if r.Name == name {
+			return r.Reason == reasonCheckDisabled
+		}
+	}
+	return false
+}
+
+// reasonCheckDisabled is reported by a detector whose check is turned off. Such results are
+// listed, so the check's state is visible, but not scored.
+const reasonCheckDisabled = "CheckDisabled"
+
+// checkDisabled is the result of a detector whose check is turned off by how.
+func checkDisabled(how string) DetectorResult {
+	return DetectorResult{Status: metav1.ConditionUnknown, Reason: reasonCheckDisabled, Evidence: how}
+}
+
+// lookupFailed is the result of a detector that could not read host state.
+func lookupFailed(err error) DetectorResult {
+	return DetectorResult{
+		Status:   metav1.ConditionUnknown,
+		Reason:   "LookupFailed",
+		Evidence: err.Error(),
+	}
+}
+
+// apiSyncDetector reports whether a ready endpoint serves the vCluster API Service port (see HostSnapshot.APIEndpoints).
+type apiSyncDetector struct{}
+
+func (apiSyncDetector) Name() string { return SignalAPISync }
+
+func (apiSyncDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	eps, err := snap.APIEndpoints(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	svc := fmt.Sprintf("Service %s/%s port %d", c.Namespace, c.ServiceName, c.ServicePort)
+	switch {
+	case eps.Ready > 0:
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "EndpointsReady",
+			Evidence: fmt.Sprintf("%s: %d/%d endpoints ready", svc, eps.Ready, eps.Total),
+		}
+	case eps.Total > 0:
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "NoReadyEndpoints",
+			Evidence: fmt.Sprintf("%s: 0/%d endpoints ready", svc, eps.Total),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionFalse,
+		Reason:   "NoEndpoints",
+		Evidence: fmt.Sprintf("%s: no endpoints", svc),
+	}
+}
+
+// controlPlaneDetector reports whether every desired control-plane replica is ready (see HostSnapshot.ControlPlane).
+type controlPlaneDetector struct{}
+
+func (controlPlaneDetector) Name() string { return SignalControlPlaneReady }
+
+func (controlPlaneDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	cp, err := snap.ControlPlane(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	workload := cp.Kind + " " + cp.Name
+	if cp.Name == "" {
+		workload = "control-plane pods"
+	}
+	switch {
+	case len(cp.Pods) == 0:
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "PodNotFound",
+			Evidence: fmt.Sprintf("no control-plane pods for %s in namespace %s (%d desired)", c.Name, c.Namespace, cp.Desired),
+		}
+	case cp.Ready >= cp.Desired:
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "ReplicasReady",
+			Evidence: fmt.Sprintf("%s: %d/%d replicas ready", workload, cp.Ready, cp.Desired),
+		}
+	case cp.Ready == 0:
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "NoReplicasReady",
+			Evidence: fmt.Sprintf("%s: 0/%d replicas ready", workload, cp.Desired),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionFalse,
+		Reason:   "PartiallyReady",
+		Evidence: fmt.Sprintf("%s: %d/%d replicas ready", workload, cp.Ready, cp.Desired),
+	}
+}
+
+// dnsSyncDetector wraps findDNSService.
+type dnsSyncDetector struct{}
+
+func (dnsSyncDetector) Name() string { return SignalDNSSync }
+
+func (dnsSyncDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	svcs, err := snap.Services(ctx, c.Namespace)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if svc := findDNSService(c.Name, c.Namespace, svcs); svc != nil {
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "ServiceFound",
+			Evidence: fmt.Sprintf("Service %s found in namespace %s", svc.Name, c.Namespace),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionFalse,
+		Reason:   "ServiceNotFound",
+		Evidence: fmt.Sprintf("no Service %s in namespace %s", dnsServiceName(c.Name), c.Namespace),
+	}
+}
+
+// nodeSyncDetector wraps countNodeServices.
+type nodeSyncDetector struct{}
+
+func (nodeSyncDetector) Name() string { return SignalNodeSync }
+
+func (nodeSyncDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	svcs, err := snap.Services(ctx, c.Namespace)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if n := countNodeServices(c.Name, c.Namespace, svcs); n > 0 {
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "ServiceFound",
+			Evidence: fmt.Sprintf("%d Services matching %s-node-* in namespace %s", n, c.Name, c.Namespace),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionFalse,
+		Reason:   "ServiceNotFound",
+		Evidence: fmt.Sprintf("no Service matching %s-node-* in namespace %s", c.Name, c.Namespace),
+	}
+}
+
+// workloadSyncDetector reports the system half of HostSnapshot.Workloads when system is true,
+// the tenant half otherwise. Synced pods only count as synced when at least minHealthyPercent
+// of them are Ready or Succeeded.
+type workloadSyncDetector struct {
+	system bool
+}
+
+func (d workloadSyncDetector) Name() string {
+	if d.system {
+		return SignalSystemWorkloadSync
+	}
+	return SignalTenantWorkloadSync
+}
+
+func (d workloadSyncDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	wl, err := snap.Workloads(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	counts, kind := wl.Tenant, "tenant"
+	if d.system {
+		counts, kind = wl.System, "kube-system"
+	}
+	if counts.Total == 0 {
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "NoWorkloadsSynced",
+			Evidence: fmt.Sprintf("no synced %s pods observed for %s", kind, c.Name),
+		}
+	}
+	healthy := healthyPercent(counts)
+	evidence := fmt.Sprintf("%d synced %s pods for %s, %d%% healthy: %s", counts.Total, kind, c.Name, healthy, describeCounts(counts))
+	if healthy < snap.minHealthyPercent {
+		return DetectorResult{
+			Status:   metav1.ConditionFalse,
+			Reason:   "WorkloadsDegraded",
+			Evidence: fmt.Sprintf("%s (minimum %d%%)", evidence, snap.minHealthyPercent),
+		}
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionTrue,
+		Reason:   "WorkloadsSynced",
+		Evidence: evidence,
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// staticDetector always reports the same result; used to exercise the registry.
+type staticDetector struct {
+	name   string
+	result DetectorResult
+}
+
+func (d staticDetector) Name() string { return d.name }
+
+func (d staticDetector) Evaluate(context.Context, fleetv1alpha1.DiscoveredCluster, *HostSnapshot) DetectorResult {
+	return d.result
+}
+
+// newTestSnapshot returns a HostSnapshot over a fake client holding objs, with the cache indexes registered.
+func newTestSnapshot(objs ...client.Object) *HostSnapshot {
+	c := fake.NewClientBuilder().
+		WithScheme(newFakeScheme()).
+		WithObjects(objs...).
+		WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+		WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+		Build()
+	return NewHostSnapshot(c)
+}
+
+// newConfigSnapshot is newTestSnapshot with Helm release and config Secret reads enabled.
+func newConfigSnapshot(objs ...client.Object) *HostSnapshot {
+	s := newTestSnapshot(objs...)
+	s.readConfig = true
+	return s
+}
+
+var _ = Describe("detector registry", func() {
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+
+	It("rejects duplicate detector names", func() {
+		r := NewDetectorRegistry(staticDetector{name: "custom"})
+		Expect(r.Register(staticDetector{name: "custom"})).To(HaveOccurred())
+		Expect(r.Detectors()).To(HaveLen(1))
+	})
+
+	It("evaluates custom detectors alongside the built-ins", func() {
+		r := DefaultDetectorRegistry()
+		Expect(r.Register(staticDetector{
+			name:   "custom",
+			result: DetectorResult{Status: metav1.ConditionTrue, Reason: "Custom"},
+		})).To(Succeed())
+
+		signals := r.Evaluate(context.Background(), cluster, newTestSnapshot())
+		builtins := len(DefaultDetectorRegistry().Detectors())
+		Expect(signals).To(HaveLen(builtins + 1))
+		Expect(signals[builtins].Name).To(Equal("custom"))
+		Expect(signals[builtins].Reason).To(Equal("Custom"))
+		Expect(signalTrue(signals, "custom")).To(BeTrue())
+		Expect(signalTrue(signals, SignalAPISync)).To(BeFalse())
+		Expect(signalTrue(signals, SignalDNSSync)).To(BeFalse())
+	})
+
+	It("reports the built-in signals against a host snapshot", func() {
+		snap := newTestSnapshot(
+			apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"}),
+			apiEndpointSlice("vc-prod", "vcluster", "", true),
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns-x-kube-system-x-vc-prod", Namespace: "vcluster"}},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "coredns-x-kube-system-x-vc-prod",
+					Namespace: "vcluster",
+					Labels: map[string]string{
+						"vcluster.loft.sh/managed-by": "vc-prod",
+						"vcluster.loft.sh/namespace":  "kube-system",
+					},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+			},
+			// Another vCluster's tenant pod must not leak into vc-prod's workload signals.
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nginx-x-default-x-vc-dev",
+					Namespace: "vcluster",
+					Labels: map[string]string{
+						"vcluster.loft.sh/managed-by": "vc-dev",
+						"vcluster.loft.sh/namespace":  "default",
+					},
+				},
+			},
+		)
+
+		signals := DefaultDetectorRegistry().Evaluate(context.Background(), cluster, snap)
+		byName := map[string]fleetv1alpha1.SignalResult{}
+		for _, s := range signals {
+			byName[s.Name] = s
+		}
+
+		Expect(byName[SignalAPISync].Status).To(Equal(metav1.ConditionTrue))
+		Expect(byName[SignalControlPlaneReady].Status).To(Equal(metav1.ConditionFalse))
+		Expect(byName[SignalControlPlaneReady].Reason).To(Equal("NoReplicasReady"))
+		Expect(byName[SignalDNSSync].Status).To(Equal(metav1.ConditionTrue))
+		Expect(byName[SignalNodeSync].Reason).To(Equal("ServiceNotFound"))
+		Expect(byName[SignalSystemWorkloadSync].Status).To(Equal(metav1.ConditionTrue))
+		Expect(byName[SignalTenantWorkloadSync].Status).To(Equal(metav1.ConditionFalse))
+		Expect(snap.Err()).To(BeEmpty())
+	})
+
+	It("reports Unknown and records the error when a lookup fails", func() {
+		listErr := errors.New("cache not synced")
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+			WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*corev1.PodList); ok {
+						return listErr
+					}
+					return cl.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+		snap := NewHostSnapshot(c)
+
+		res := workloadSyncDetector{system: true}.Evaluate(context.Background(), cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(res.Reason).To(Equal("LookupFailed"))
+
+		reason, err := snap.Err()
+		Expect(reason).To(Equal(ReasonListPodsFailed))
+		Expect(err).To(MatchError(listErr))
+	})
+})
+
+var _ = Describe("podVClusterOwners", func() {
+	It("returns each distinct owner label value", func() {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"vcluster.loft.sh/managed-by":    "vc-prod",
+			"vcluster.loft.sh/vcluster-name": "vc-prod",
+			"vcluster.loft.sh/owner":         "vc-other",
+			"app":                            "nginx",
+		}}}
+		Expect(podVClusterOwners(pod)).To(Equal([]string{"vc-prod", "vc-other"}))
+		Expect(podVClusterOwners(&corev1.Pod{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// defaultServiceSelector matches the API Services created by the vCluster chart.
+var defaultServiceSelector = labels.SelectorFromSet(labels.Set{"app": "vcluster"})
+
+// discoverySelectors returns the Service selectors from spec.discovery.
+// include defaults to app=vcluster; exclude is nil when unset.
+func discoverySelectors(spec fleetv1alpha1.VClusterHealthSpec) (include, exclude labels.Selector, err error) {
+	include = defaultServiceSelector
+	d := spec.Discovery
+	if d == nil {
+		return include, nil, nil
+	}
+	if d.ServiceSelector != nil {
+		if include, err = metav1.LabelSelectorAsSelector(d.ServiceSelector); err != nil {
+			return nil, nil, fmt.Errorf("spec.discovery.serviceSelector: %w", err)
+		}
+	}
+	if d.ExcludeSelector != nil {
+		if exclude, err = metav1.LabelSelectorAsSelector(d.ExcludeSelector); err != nil {
+			return nil, nil, fmt.Errorf("spec.discovery.excludeSelector: %w", err)
+		}
+	}
+	return include, exclude, nil
+}
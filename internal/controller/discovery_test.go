@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// reconcileFleet runs one reconcile of vh against a fake client holding objs and returns the stored object.
+func reconcileFleet(vh *fleetv1alpha1.VClusterHealth, objs ...client.Object) (*fleetv1alpha1.VClusterHealth, error) {
+	return reconcileFleetWith(&VClusterHealthReconciler{}, vh, objs...)
+}
+
+// reconcileFleetWith is reconcileFleet with r's options, such as ReadVClusterConfig; its client is replaced.
+func reconcileFleetWith(r *VClusterHealthReconciler, vh *fleetv1alpha1.VClusterHealth, objs ...client.Object) (*fleetv1alpha1.VClusterHealth, error) {
+	c := fake.NewClientBuilder().
+		WithScheme(newFakeScheme()).
+		WithObjects(append(objs, vh)...).
+		WithStatusSubresource(vh).
+		WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+		WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourceApply: fakeStatusApply}).
+		Build()
+	r.Client, r.Scheme = c, c.Scheme()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vh)})
+
+	var got fleetv1alpha1.VClusterHealth
+	Expect(c.Get(context.Background(), types.NamespacedName{Namespace: vh.Namespace, Name: vh.Name}, &got)).To(Succeed())
+	return &got, err
+}
+
+// apiService returns a vCluster API Service with a ClusterIP and port 443.
+func apiService(name, ns string, lbls map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: lbls},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1", Ports: []corev1.ServicePort{{Port: 443}}},
+	}
+}
+
+var _ = Describe("service discovery", func() {
+	It("defaults to app=vcluster with no exclusions", func() {
+		include, exclude, err := discoverySelectors(fleetv1alpha1.VClusterHealthSpec{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(include.Matches(labels.Set{"app": "vcluster"})).To(BeTrue())
+		Expect(include.Matches(labels.Set{"app": "other"})).To(BeFalse())
+		Expect(exclude).To(BeNil())
+	})
+
+	It("rejects invalid selectors", func() {
+		_, _, err := discoverySelectors(fleetv1alpha1.VClusterHealthSpec{Discovery: &fleetv1alpha1.DiscoverySpec{
+			ExcludeSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}},
+		}})
+		Expect(err).To(MatchError(ContainSubstring("spec.discovery.excludeSelector")))
+	})
+
+	It("discovers Services by the configured selector minus exclusions", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec: fleetv1alpha1.VClusterHealthSpec{
+				Namespace: "vcluster",
+				Discovery: &fleetv1alpha1.DiscoverySpec{
+					ServiceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": "vcluster"}},
+					ExcludeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"vcluster.example.com/ignore": "true"}},
+				},
+			},
+		}
+		got, err := reconcileFleet(vh,
+			apiService("vc-new", "vcluster", map[string]string{"app.kubernetes.io/name": "vcluster"}),
+			apiService("vc-ignored", "vcluster", map[string]string{"app.kubernetes.io/name": "vcluster", "vcluster.example.com/ignore": "true"}),
+			apiService("vc-legacy", "vcluster", map[string]string{"app": "vcluster"}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.Clusters).To(HaveLen(1))
+		Expect(got.Status.Clusters[0].Name).To(Equal("vc-new"))
+	})
+
+	It("marks the fleet Stalled without retrying on an invalid selector", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec: fleetv1alpha1.VClusterHealthSpec{Discovery: &fleetv1alpha1.DiscoverySpec{
+				ServiceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"bad key!": "x"}},
+			}},
+		}
+		got, err := reconcileFleet(vh)
+		Expect(err).NotTo(HaveOccurred())
+		stalled := meta.FindStatusCondition(got.Status.Conditions, fleetv1alpha1.ConditionStalled)
+		Expect(stalled).NotTo(BeNil())
+		Expect(stalled.Reason).To(Equal(ReasonInvalidDiscoverySelector))
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// Kubernetes distributions a vCluster control plane can run.
+const (
+	DistroK3s = "k3s"
+	DistroK8s = "k8s"
+	DistroK0s = "k0s"
+	DistroEKS = "eks"
+)
+
+// vclusterConfigVersion is the first vCluster release configured through vcluster.yaml; older
+// releases take legacy chart values.
+var vclusterConfigVersion = version.MustParseGeneric("0.20.0")
+
+// syncerImages are the image names the vCluster syncer ships as.
+var syncerImages = map[string]bool{"vcluster": true, "vcluster-oss": true, "vcluster-pro": true}
+
+// DistroInfo is what the control-plane containers reveal about a vCluster.
+type DistroInfo struct {
+	Distro            string
+	VClusterVersion   string
+	KubernetesVersion string
+}
+
+// detectDistro reads the distro and versions from the images of the control-plane containers,
+// init containers included (vCluster ≥0.20 copies the distro binaries in an init container):
+//   - vcluster, vcluster-oss, vcluster-pro: the syncer, tagged with the vCluster version;
+//   - k3s (v1.30.2-k3s1), k0s (v1.30.2-k0s.0): the distro, tagged with the Kubernetes version;
+//   - kube-apiserver or loft-sh/kubernetes: k8s, or eks for EKS Distro images.
+//
+// Images from a mirror that renames them are recognised by their command and args instead.
+func detectDistro(pods []corev1.Pod) DistroInfo {
+	var info DistroInfo
+	for i := range pods {
+		for _, list := range [][]corev1.Container{pods[i].Spec.InitContainers, pods[i].Spec.Containers} {
+			for _, ctr := range list {
+				inspectContainer(&info, ctr)
+			}
+		}
+	}
+	return info
+}
+
+func inspectContainer(info *DistroInfo, ctr corev1.Container) {
+	repo, tag := splitImage(ctr.Image)
+	name := path.Base(repo)
+	switch {
+	case syncerImages[name]:
+		if info.VClusterVersion == "" {
+			info.VClusterVersion = strings.TrimPrefix(tag, "v")
+		}
+		return
+	case name == "k3s":
+		setDistro(info, DistroK3s, tag)
+		return
+	case name == "k0s":
+		setDistro(info, DistroK0s, tag)
+		return
+	case name == "kube-apiserver" || (name == "kubernetes" && strings.Contains(repo, "loft-sh")):
+		if strings.Contains(repo, "eks-distro") {
+			setDistro(info, DistroEKS, tag)
+		} else {
+			setDistro(info, DistroK8s, tag)
+		}
+		return
+	}
+
+	cmdline := strings.Join(append(append([]string{}, ctr.Command...), ctr.Args...), " ")
+	switch {
+	case strings.Contains(cmdline, "k3s server"), strings.Contains(cmdline, "/k3s "):
+		setDistro(info, DistroK3s, tag)
+	case strings.Contains(cmdline, "k0s controller"):
+		setDistro(info, DistroK0s, tag)
+	case strings.Contains(cmdline, "kube-apiserver"):
+		setDistro(info, DistroK8s, tag)
+	}
+}
+
+// setDistro records the first distro seen, with the Kubernetes version from its image tag.
+func setDistro(info *DistroInfo, distro, tag string) {
+	if info.Distro != "" {
+		return
+	}
+	info.Distro = distro
+	if v, err := version.ParseGeneric(tag); err == nil {
+		info.KubernetesVersion = "v" + v.String()
+	}
+}
+
+// splitImage splits an image reference into repository and tag; a digest is dropped.
+func splitImage(image string) (repo, tag string) {
+	image, _, _ = strings.Cut(image, "@")
+	// A colon after the last slash separates the tag; one before it belongs to a registry port.
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
+
+// usesLegacyValues reports whether a vCluster of version v (or, if unknown, chart version chart)
+// predates vcluster.yaml. Unknown versions are assumed current.
+func usesLegacyValues(v, chart string) bool {
+	for _, s := range []string{v, chart} {
+		if parsed, err := version.ParseGeneric(s); err == nil {
+			return parsed.LessThan(vclusterConfigVersion)
+		}
+	}
+	return false
+}
+
+// Distro inspects c's control-plane pods.
+func (s *HostSnapshot) Distro(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (DistroInfo, error) {
+	cp, err := s.ControlPlane(ctx, c)
+	if err != nil {
+		return DistroInfo{}, err
+	}
+	return detectDistro(cp.Pods), nil
+}
+
+// describeCluster fills in c's distro, versions and Helm release before detectors run, so they can
+// pick version-appropriate behaviour. Lookup errors are left for the detectors to report.
+func (s *HostSnapshot) describeCluster(ctx context.Context, c *fleetv1alpha1.DiscoveredCluster) {
+	if info, err := s.Distro(ctx, *c); err == nil {
+		c.Distro, c.VClusterVersion, c.KubernetesVersion = info.Distro, info.VClusterVersion, info.KubernetesVersion
+	}
+	if rel, err := s.HelmRelease(ctx, *c); err == nil && rel != nil {
+		c.Release = rel.Status()
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("distro detection", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+
+	podWith := func(init []corev1.Container, containers ...corev1.Container) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster", "release": "vc-prod"}},
+			Spec:       corev1.PodSpec{InitContainers: init, Containers: containers},
+		}
+	}
+
+	It("reads distro and versions from control-plane images", func() {
+		detect := func(pod corev1.Pod) DistroInfo { return detectDistro([]corev1.Pod{pod}) }
+
+		Expect(detect(podWith(
+			[]corev1.Container{{Name: "kubernetes", Image: "ghcr.io/loft-sh/kubernetes:v1.30.2"}},
+			corev1.Container{Name: "syncer", Image: "ghcr.io/loft-sh/vcluster-pro:0.20.0"},
+		))).To(Equal(DistroInfo{Distro: DistroK8s, VClusterVersion: "0.20.0", KubernetesVersion: "v1.30.2"}))
+
+		Expect(detect(podWith(nil,
+			corev1.Container{Name: "vcluster", Image: "rancher/k3s:v1.26.4-k3s1"},
+			corev1.Container{Name: "syncer", Image: "loftsh/vcluster:v0.15.0"},
+		))).To(Equal(DistroInfo{Distro: DistroK3s, VClusterVersion: "0.15.0", KubernetesVersion: "v1.26.4"}))
+
+		Expect(detect(podWith(nil,
+			corev1.Container{Name: "kube-apiserver", Image: "mirror:5000/eks-distro/kubernetes/kube-apiserver:v1.25.6-eks-1-25-9@sha256:abc"},
+			corev1.Container{Name: "syncer", Image: "mirror:5000/loft-sh/vcluster:0.15.2"},
+		))).To(Equal(DistroInfo{Distro: DistroEKS, VClusterVersion: "0.15.2", KubernetesVersion: "v1.25.6"}))
+
+		Expect(detect(podWith(nil,
+			corev1.Container{Name: "vcluster", Image: "mirror/k0s-custom:v1.29.1-k0s.0", Command: []string{"k0s", "controller"}},
+		))).To(Equal(DistroInfo{Distro: DistroK0s, KubernetesVersion: "v1.29.1"}), "renamed images are recognised by their command")
+	})
+
+	It("decides which config format applies", func() {
+		Expect(usesLegacyValues("0.19.5", "")).To(BeTrue())
+		Expect(usesLegacyValues("0.20.0", "0.15.0")).To(BeFalse(), "the image version wins")
+		Expect(usesLegacyValues("", "0.15.0")).To(BeTrue())
+		Expect(usesLegacyValues("", "")).To(BeFalse())
+	})
+
+	It("reads legacy Helm values for vClusters before 0.20", func() {
+		rel := helmReleaseSecret(map[string]any{
+			"name":    "vc-prod",
+			"version": 3,
+			"info":    map[string]any{"status": "deployed"},
+			"chart":   map[string]any{"metadata": map[string]any{"name": "vcluster", "version": "0.15.0"}},
+			"config": map[string]any{
+				"sync":    map[string]any{"nodes": map[string]any{"fakeKubeletIPs": false}},
+				"coredns": map[string]any{"integrated": true},
+			},
+		})
+		// A stale vcluster.yaml Secret is ignored for legacy versions.
+		stale := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-config-vc-prod", Namespace: "vcluster"},
+			Data:       map[string][]byte{"config.yaml": []byte("sync:\n  toHost:\n    pods:\n      enabled: false\n")},
+		}
+		legacy := cluster
+		legacy.VClusterVersion = "0.15.0"
+
+		e, err := newConfigSnapshot(rel, stale).Expectations(ctx, legacy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Source).To(Equal("HelmRelease/vc-prod.v3"))
+		Expect(e.Expected(SignalNodeSync)).To(BeFalse())
+		Expect(e.Expected(SignalSystemWorkloadSync)).To(BeFalse())
+		Expect(e.Expected(SignalTenantWorkloadSync)).To(BeTrue())
+
+		e, err = newConfigSnapshot(rel, stale).Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Source).To(Equal("HelmRelease/vc-prod.v3"), "the chart version marks the release as legacy")
+	})
+
+	It("reports distro and versions on the discovered cluster", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		pod := podWith(nil,
+			corev1.Container{Name: "vcluster", Image: "rancher/k3s:v1.26.4-k3s1"},
+			corev1.Container{Name: "syncer", Image: "loftsh/vcluster:0.15.0"},
+		)
+		got, err := reconcileFleet(vh, apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"}), &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.Clusters).To(HaveLen(1))
+		c := got.Status.Clusters[0]
+		Expect([]string{c.Distro, c.VClusterVersion, c.KubernetesVersion}).To(Equal([]string{DistroK3s, "0.15.0", "v1.26.4"}))
+	})
+
+	It("detects the distro of custom-selected control-plane pods under the lean cache", func() {
+		custom := podWith(nil,
+			corev1.Container{Name: "vcluster", Image: "rancher/k3s:v1.29.0-k3s1"},
+			corev1.Container{Name: "syncer", Image: "ghcr.io/loft-sh/vcluster:0.19.5"},
+		)
+		custom.Labels = map[string]string{"component": "api", "vcluster.example.com/name": "vc-prod"}
+		cached, err := transformPod(custom.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cached.(*corev1.Pod).Spec.Containers).To(HaveLen(2), "only synced pods lose their containers")
+
+		sel, err := controlPlaneSelectorFor(fleetv1alpha1.VClusterHealthSpec{ControlPlane: &fleetv1alpha1.ControlPlaneSpec{
+			PodSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"component": "api"}},
+			ClusterLabel: "vcluster.example.com/name",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		snap := newTestSnapshot(cached.(*corev1.Pod))
+		snap.controlPlaneSelector = sel
+
+		Expect(snap.Distro(ctx, cluster)).To(Equal(DistroInfo{Distro: DistroK3s, VClusterVersion: "0.19.5", KubernetesVersion: "v1.29.0"}))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// EndpointsState counts the endpoints serving the vCluster API Service port.
+type EndpointsState struct {
+	// PortName is the name of the Service port, as EndpointSlices refer to it ("" if unnamed).
+	PortName string
+	// Ready and Total count distinct endpoints serving PortName.
+	Ready int32
+	Total int32
+}
+
+// Status returns the state as reported in SyncCoverage.
+func (s *EndpointsState) Status() *fleetv1alpha1.EndpointsStatus {
+	return &fleetv1alpha1.EndpointsStatus{Ready: s.Ready, Total: s.Total}
+}
+
+// APIEndpoints counts the EndpointSlice endpoints serving c.ServicePort of the vCluster API Service.
+// EndpointSlices name ports after the Service port, so the port number is first resolved to its name.
+func (s *HostSnapshot) APIEndpoints(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*EndpointsState, error) {
+	svcs, err := s.Services(ctx, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	st := &EndpointsState{}
+	port, ok := servicePort(svcs, c.ServiceName, c.ServicePort)
+	if !ok {
+		return st, nil
+	}
+	st.PortName = port.Name
+
+	eps, err := s.EndpointSlices(ctx, c.Namespace, c.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	// Dual-stack Services have one slice per address family; count each endpoint once.
+	ready := map[string]bool{}
+	for i := range eps {
+		if !slicePortServes(eps[i].Ports, port) {
+			continue
+		}
+		for _, ep := range eps[i].Endpoints {
+			key := endpointKey(ep)
+			ready[key] = ready[key] || endpointReady(ep)
+		}
+	}
+	for _, r := range ready {
+		st.Total++
+		if r {
+			st.Ready++
+		}
+	}
+	return st, nil
+}
+
+// servicePort returns the port of the named Service with the given number.
+func servicePort(svcs []corev1.Service, name string, number int32) (corev1.ServicePort, bool) {
+	for i := range svcs {
+		if svcs[i].Name != name {
+			continue
+		}
+		for _, p := range svcs[i].Spec.Ports {
+			if p.Port == number {
+				return p, true
+			}
+		}
+	}
+	return corev1.ServicePort{}, false
+}
+
+// slicePortServes reports whether an EndpointSlice port list includes the Service port.
+func slicePortServes(ports []discoveryv1.EndpointPort, svcPort corev1.ServicePort) bool {
+	protocol := svcPort.Protocol
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	for _, p := range ports {
+		name := ""
+		if p.Name != nil {
+			name = *p.Name
+		}
+		proto := corev1.ProtocolTCP
+		if p.Protocol != nil {
+			proto = *p.Protocol
+		}
+		if name == svcPort.Name && proto == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointKey identifies an endpoint across slices: by its target object, else its first address.
+func endpointKey(ep discoveryv1.Endpoint) string {
+	if ep.TargetRef != nil {
+		return ep.TargetRef.Kind + "/" + ep.TargetRef.Namespace + "/" + ep.TargetRef.Name
+	}
+	if len(ep.Addresses) > 0 {
+		return ep.Addresses[0]
+	}
+	return ""
+}
+
+// endpointReady follows the EndpointSlice API: an unset ready condition means ready.
+func endpointReady(ep discoveryv1.Endpoint) bool {
+	return ep.Conditions.Ready == nil || *ep.Conditions.Ready
+}
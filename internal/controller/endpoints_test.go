@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// apiEndpointSlice returns an EndpointSlice for the named Service with one endpoint per ready flag,
+// serving the port named portName.
+func apiEndpointSlice(service, ns, portName string, ready ...bool) *discoveryv1.EndpointSlice {
+	s := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service + "-" + fmt.Sprint(len(ready)),
+			Namespace: ns,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Ports:       []discoveryv1.EndpointPort{{Name: ptr.To(portName), Port: ptr.To[int32](8443)}},
+	}
+	for i, r := range ready {
+		s.Endpoints = append(s.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{fmt.Sprintf("10.1.0.%d", i+1)},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(r)},
+			TargetRef:  &corev1.ObjectReference{Kind: "Pod", Namespace: ns, Name: fmt.Sprintf("%s-%d", service, i)},
+		})
+	}
+	return s
+}
+
+var _ = Describe("API endpoint readiness", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+	svc := apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"})
+
+	It("is true only with a ready endpoint", func() {
+		res := apiSyncDetector{}.Evaluate(ctx, cluster, newTestSnapshot(svc, apiEndpointSlice("vc-prod", "vcluster", "", false, true)))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("EndpointsReady"))
+		Expect(res.Evidence).To(Equal("Service vcluster/vc-prod port 443: 1/2 endpoints ready"))
+
+		res = apiSyncDetector{}.Evaluate(ctx, cluster, newTestSnapshot(svc, apiEndpointSlice("vc-prod", "vcluster", "", false)))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("NoReadyEndpoints"))
+
+		res = apiSyncDetector{}.Evaluate(ctx, cluster, newTestSnapshot(svc))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("NoEndpoints"))
+	})
+
+	It("only counts endpoints serving the selected Service port", func() {
+		named := svc.DeepCopy()
+		named.Spec.Ports = []corev1.ServicePort{{Name: "https", Port: 443}, {Name: "metrics", Port: 9090}}
+
+		eps, err := newTestSnapshot(named,
+			apiEndpointSlice("vc-prod", "vcluster", "metrics", true),
+			apiEndpointSlice("vc-prod", "vcluster", "https", false, false),
+		).APIEndpoints(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eps.Status()).To(Equal(&fleetv1alpha1.EndpointsStatus{Ready: 0, Total: 2}))
+	})
+
+	It("counts an endpoint once across dual-stack slices", func() {
+		v4 := apiEndpointSlice("vc-prod", "vcluster", "", true)
+		v6 := v4.DeepCopy()
+		v6.Name, v6.AddressType = "vc-prod-v6", discoveryv1.AddressTypeIPv6
+		v6.Endpoints[0].Addresses = []string{"fd00::1"}
+
+		eps, err := newTestSnapshot(svc, v4, v6).APIEndpoints(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eps.Status()).To(Equal(&fleetv1alpha1.EndpointsStatus{Ready: 1, Total: 1}))
+	})
+
+	It("reconciles only on readiness changes", func() {
+		p := endpointReadinessChanged()
+		old := apiEndpointSlice("vc-prod", "vcluster", "", true)
+
+		moved := old.DeepCopy()
+		moved.Endpoints[0].NodeName = ptr.To("node-b")
+		Expect(p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: moved})).To(BeFalse())
+
+		notReady := old.DeepCopy()
+		notReady.Endpoints[0].Conditions.Ready = ptr.To(false)
+		Expect(p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: notReady})).To(BeTrue())
+	})
+})
@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// EventActionHealthCheck is the action recorded on every transition event.
+const EventActionHealthCheck = "HealthCheck"
+
+// ReasonLevelChanged is the event reason for a per-cluster level change.
+const ReasonLevelChanged = "LevelChanged"
+
+// Transition events per vCluster: a burst of eventBurst, then one per eventRefillEvery.
+const (
+	eventBurst       = 5
+	eventRefillEvery = time.Minute
+)
+
+// signalEventReasons are the (lost, regained) event reasons of the built-in signals.
+// Other signals use <Type>Lost and <Type>Restored.
+var signalEventReasons = map[string][2]string{
+	SignalAPISync:             {"ApiSyncLost", "ApiSyncRestored"},
+	SignalControlPlaneReady:   {"ControlPlaneNotReady", "ControlPlaneReady"},
+	SignalControlPlaneStable:  {"ControlPlaneUnstable", "ControlPlaneStabilized"},
+	SignalBackingStoreHealthy: {"BackingStoreFailed", "BackingStoreRecovered"},
+	SignalReleaseHealthy:      {"ReleaseFailed", "ReleaseDeployed"},
+	SignalUpToDate:            {"VersionOutOfPolicy", "VersionInPolicy"},
+	SignalCertificatesValid:   {"CertificatesInvalid", "CertificatesRenewed"},
+	SignalDNSSync:             {"DnsSyncLost", "DnsSyncRestored"},
+	SignalNodeSync:            {"NodeSyncLost", "NodeSyncRestored"},
+	SignalSystemWorkloadSync:  {"SystemWorkloadsGone", "SystemWorkloadsAppeared"},
+	SignalTenantWorkloadSync:  {"TenantWorkloadsGone", "TenantWorkloadsAppeared"},
+}
+
+// signalWarningReasons maps reasons under which a signal stays True but needs attention to the
+// Warning event emitted when the signal takes that reason.
+var signalWarningReasons = map[string]map[string]string{
+	SignalCertificatesValid: {"ExpiringSoon": "CertificatesExpiringSoon"},
+}
+
+// healthTransition is one event-worthy change of a vCluster between two reconciles.
+type healthTransition struct {
+	Type   string // corev1.EventTypeNormal or corev1.EventTypeWarning
+	Reason string
+	Note   string
+}
+
+// healthTransitions compares a vCluster's previous and current coverage. Signals transition
+// when they enter or leave status True, or take a reason in signalWarningReasons while True;
+// a vCluster seen for the first time has no transitions.
+func healthTransitions(prev *fleetv1alpha1.SyncCoverage, cur fleetv1alpha1.SyncCoverage) []healthTransition {
+	if prev == nil {
+		return nil
+	}
+	was := make(map[string]fleetv1alpha1.SignalResult, len(prev.Signals))
+	for _, s := range prev.Signals {
+		was[s.Name] = s
+	}
+
+	var out []healthTransition
+	for _, s := range cur.Signals {
+		p, seen := was[s.Name]
+		if !seen {
+			continue
+		}
+		before := p.Status == metav1.ConditionTrue
+		now := s.Status == metav1.ConditionTrue
+		warning, warn := signalWarningReasons[s.Name][s.Reason]
+		warn = warn && now && !(before && p.Reason == s.Reason)
+		if before == now && !warn {
+			continue
+		}
+		reasons, ok := signalEventReasons[s.Name]
+		if !ok {
+			t := signalConditionType(s.Name)
+			reasons = [2]string{t + "Lost", t + "Restored"}
+		}
+		t := healthTransition{Type: corev1.EventTypeWarning, Reason: reasons[0]}
+		switch {
+		case warn:
+			t = healthTransition{Type: corev1.EventTypeWarning, Reason: warning}
+		case now:
+			t = healthTransition{Type: corev1.EventTypeNormal, Reason: reasons[1]}
+		}
+		t.Note = fmt.Sprintf("vCluster %s: %s is %s (%s)", cur.ClusterName, s.Name, s.Status, s.Reason)
+		if s.Evidence != "" {
+			t.Note += ": " + s.Evidence
+		}
+		out = append(out, t)
+	}
+
+	if prev.Level != cur.Level {
+		t := healthTransition{Type: corev1.EventTypeNormal, Reason: ReasonLevelChanged}
+		if cur.Score < prev.Score {
+			t.Type = corev1.EventTypeWarning
+		}
+		t.Note = fmt.Sprintf("vCluster %s: level %s -> %s (score %d -> %d)", cur.ClusterName, prev.Level, cur.Level, prev.Score, cur.Score)
+		out = append(out, t)
+	}
+	return out
+}
+
+// transitionLimiter rate-limits transition events per vCluster, so a flapping cluster
+// cannot flood the event stream. The zero value is ready to use.
+type transitionLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// allow reports whether another event may be emitted for key at now.
+func (l *transitionLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limiters == nil {
+		l.limiters = map[string]*rate.Limiter{}
+	}
+	// A fully refilled bucket is the same as a fresh one, so it can be dropped; this keeps
+	// the map from growing with vanished clusters.
+	for k, lim := range l.limiters {
+		if k != key && lim.TokensAt(now) >= eventBurst {
+			delete(l.limiters, k)
+		}
+	}
+	lim, ok := l.limiters[key]
+	if !ok {
+		lim = rate.NewLimiter(rate.Every(eventRefillEvery), eventBurst)
+		l.limiters[key] = lim
+	}
+	return lim.AllowN(now, 1)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// objectRecorder records "<Kind>/<name> <type> <reason>" for every event.
+type objectRecorder struct {
+	events []string
+}
+
+func (r *objectRecorder) Eventf(regarding, _ runtime.Object, eventtype, reason, _, _ string, _ ...any) {
+	kind := "?"
+	switch regarding.(type) {
+	case *fleetv1alpha1.VClusterHealth:
+		kind = "VClusterHealth"
+	case *corev1.Service:
+		kind = "Service"
+	}
+	r.events = append(r.events, fmt.Sprintf("%s/%s %s %s", kind, regarding.(client.Object).GetName(), eventtype, reason))
+}
+
+var _ = Describe("health transition events", func() {
+	signal := func(name string, status metav1.ConditionStatus) fleetv1alpha1.SignalResult {
+		return fleetv1alpha1.SignalResult{Name: name, Status: status, Reason: "Test"}
+	}
+
+	It("reports signals entering or leaving True and level changes", func() {
+		prev := &fleetv1alpha1.SyncCoverage{
+			ClusterName: "vc-prod", Level: "Full", Score: 100,
+			Signals: []fleetv1alpha1.SignalResult{
+				signal(SignalControlPlaneReady, metav1.ConditionTrue),
+				signal(SignalDNSSync, metav1.ConditionFalse),
+				signal(SignalNodeSync, metav1.ConditionFalse),
+				signal("custom", metav1.ConditionTrue),
+			},
+		}
+		cur := fleetv1alpha1.SyncCoverage{
+			ClusterName: "vc-prod", Level: "Partial", Score: 50,
+			Signals: []fleetv1alpha1.SignalResult{
+				signal(SignalControlPlaneReady, metav1.ConditionFalse),
+				signal(SignalDNSSync, metav1.ConditionTrue),
+				signal(SignalNodeSync, metav1.ConditionUnknown),
+				signal("custom", metav1.ConditionUnknown),
+				signal(SignalTenantWorkloadSync, metav1.ConditionTrue),
+			},
+		}
+
+		got := healthTransitions(prev, cur)
+		reasons := map[string]string{}
+		for _, t := range got {
+			reasons[t.Reason] = t.Type
+		}
+		Expect(reasons).To(Equal(map[string]string{
+			"ControlPlaneNotReady": corev1.EventTypeWarning,
+			"DnsSyncRestored":      corev1.EventTypeNormal,
+			"CustomLost":           corev1.EventTypeWarning,
+			ReasonLevelChanged:     corev1.EventTypeWarning,
+		}))
+		Expect(healthTransitions(nil, cur)).To(BeEmpty())
+	})
+
+	It("warns once when a True signal takes a warning reason", func() {
+		certs := func(status metav1.ConditionStatus, reason string) fleetv1alpha1.SyncCoverage {
+			return fleetv1alpha1.SyncCoverage{
+				ClusterName: "vc-prod", Level: "Full", Score: 100,
+				Signals: []fleetv1alpha1.SignalResult{{Name: SignalCertificatesValid, Status: status, Reason: reason}},
+			}
+		}
+		valid := certs(metav1.ConditionTrue, "Valid")
+		expiring := certs(metav1.ConditionTrue, "ExpiringSoon")
+		expired := certs(metav1.ConditionFalse, "Expired")
+
+		got := healthTransitions(&valid, expiring)
+		Expect(got).To(HaveLen(1))
+		Expect(got[0].Type).To(Equal(corev1.EventTypeWarning))
+		Expect(got[0].Reason).To(Equal("CertificatesExpiringSoon"))
+		Expect(healthTransitions(&expiring, expiring)).To(BeEmpty(), "the warning is not repeated")
+
+		got = healthTransitions(&expiring, expired)
+		Expect(got).To(HaveLen(1))
+		Expect(got[0].Reason).To(Equal("CertificatesInvalid"))
+
+		got = healthTransitions(&expired, expiring)
+		Expect(got).To(HaveLen(1))
+		Expect(got[0].Reason).To(Equal("CertificatesExpiringSoon"), "a renewal that is already expiring still warns")
+		Expect(healthTransitions(&expiring, valid)).To(BeEmpty())
+	})
+
+	It("rate-limits events per cluster and refills over time", func() {
+		var l transitionLimiter
+		now := time.Now()
+		for range eventBurst {
+			Expect(l.allow("a", now)).To(BeTrue())
+		}
+		Expect(l.allow("a", now)).To(BeFalse())
+		Expect(l.allow("b", now)).To(BeTrue(), "other clusters have their own budget")
+		Expect(l.allow("a", now.Add(eventRefillEvery))).To(BeTrue())
+	})
+
+	It("emits events on the fleet and the API Service during reconcile", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+			Status: fleetv1alpha1.VClusterHealthStatus{SyncCoverage: []fleetv1alpha1.SyncCoverage{{
+				ClusterName: "vc-prod",
+				Level:       "Full",
+				Score:       100,
+				Signals: []fleetv1alpha1.SignalResult{
+					signal(SignalAPISync, metav1.ConditionTrue),
+					signal(SignalControlPlaneReady, metav1.ConditionTrue),
+				},
+			}}},
+		}
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1", Ports: []corev1.ServicePort{{Port: 443}}},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(vh, svc).
+			WithStatusSubresource(vh).
+			WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+			WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+			WithInterceptorFuncs(interceptor.Funcs{SubResourceApply: fakeStatusApply}).
+			Build()
+		rec := &objectRecorder{}
+		r := &VClusterHealthReconciler{Client: c, Scheme: c.Scheme(), Recorder: rec}
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.events).To(ContainElements(
+			"VClusterHealth/fleet Warning ControlPlaneNotReady",
+			"Service/vc-prod Warning ControlPlaneNotReady",
+			"VClusterHealth/fleet Warning LevelChanged",
+			"Service/vc-prod Warning LevelChanged",
+		))
+	})
+
+	It("compares each cluster with its own namespace's previous coverage", func() {
+		previous := func(ns string, cpReady metav1.ConditionStatus) fleetv1alpha1.SyncCoverage {
+			return fleetv1alpha1.SyncCoverage{
+				ClusterName: "vc",
+				Namespace:   ns,
+				Signals:     []fleetv1alpha1.SignalResult{signal(SignalControlPlaneReady, cpReady)},
+			}
+		}
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{NamespacePatterns: []string{"team-*"}},
+			Status: fleetv1alpha1.VClusterHealthStatus{SyncCoverage: []fleetv1alpha1.SyncCoverage{
+				previous("team-a", metav1.ConditionTrue),
+				previous("team-b", metav1.ConditionFalse),
+			}},
+		}
+		vcluster := map[string]string{"app": "vcluster"}
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(vh,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+				apiService("vc", "team-a", vcluster),
+				apiService("vc", "team-b", vcluster),
+			).
+			WithStatusSubresource(vh).
+			WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+			WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+			WithInterceptorFuncs(interceptor.Funcs{SubResourceApply: fakeStatusApply}).
+			Build()
+		rec := &objectRecorder{}
+		r := &VClusterHealthReconciler{Client: c, Scheme: c.Scheme(), Recorder: rec}
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}})
+		Expect(err).NotTo(HaveOccurred())
+		// Only team-a's control plane was ready before; team-b's was already down.
+		Expect(rec.events).To(HaveEach(Not(HaveSuffix(" ControlPlaneRestored"))))
+		Expect(rec.events).To(ContainElements(
+			"VClusterHealth/fleet Warning ControlPlaneNotReady",
+			"Service/vc Warning ControlPlaneNotReady",
+		))
+		notReady := 0
+		for _, e := range rec.events {
+			if e == "VClusterHealth/fleet Warning ControlPlaneNotReady" {
+				notReady++
+			}
+		}
+		Expect(notReady).To(Equal(1))
+
+		var got fleetv1alpha1.VClusterHealth
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(vh), &got)).To(Succeed())
+		Expect(got.Status.SyncCoverage).To(HaveLen(2))
+		Expect([]string{got.Status.SyncCoverage[0].Namespace, got.Status.SyncCoverage[1].Namespace}).
+			To(ConsistOf("team-a", "team-b"))
+	})
+})
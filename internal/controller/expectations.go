@@ -0,0 +1,262 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+const (
+	// configSecretPrefix prefixes the vCluster name to form the Secret vCluster ≥0.20 stores its
+	// vcluster.yaml in.
+	configSecretPrefix = "vc-config-"
+	// configSecretKey is the key holding vcluster.yaml in the config Secret.
+	configSecretKey = "config.yaml"
+)
+
+// vclusterConfig is the subset of vcluster.yaml that decides which signals a vCluster can show.
+// Unset fields keep the vCluster defaults.
+type vclusterConfig struct {
+	Sync struct {
+		ToHost struct {
+			Pods     configFeature `json:"pods"`
+			Services configFeature `json:"services"`
+		} `json:"toHost"`
+		FromHost struct {
+			Nodes configFeature `json:"nodes"`
+		} `json:"fromHost"`
+	} `json:"sync"`
+	ControlPlane struct {
+		CoreDNS struct {
+			Enabled  *bool `json:"enabled"`
+			Embedded bool  `json:"embedded"`
+		} `json:"coredns"`
+	} `json:"controlPlane"`
+	Networking struct {
+		Advanced struct {
+			ProxyKubelets struct {
+				ByIP *bool `json:"byIP"`
+			} `json:"proxyKubelets"`
+		} `json:"advanced"`
+	} `json:"networking"`
+}
+
+// legacyValues is the subset of the chart values of vCluster <0.20 that decides which signals
+// a vCluster can show.
+type legacyValues struct {
+	Sync struct {
+		Pods     configFeature `json:"pods"`
+		Services configFeature `json:"services"`
+		Nodes    struct {
+			Enabled        *bool `json:"enabled"`
+			FakeKubeletIPs *bool `json:"fakeKubeletIPs"`
+		} `json:"nodes"`
+	} `json:"sync"`
+	CoreDNS struct {
+		Enabled    *bool `json:"enabled"`
+		Integrated bool  `json:"integrated"`
+	} `json:"coredns"`
+}
+
+// config translates legacy values to their vcluster.yaml equivalents.
+func (v legacyValues) config() vclusterConfig {
+	var cfg vclusterConfig
+	cfg.Sync.ToHost.Pods = v.Sync.Pods
+	cfg.Sync.ToHost.Services = v.Sync.Services
+	cfg.Sync.FromHost.Nodes.Enabled = v.Sync.Nodes.Enabled
+	cfg.ControlPlane.CoreDNS.Enabled = v.CoreDNS.Enabled
+	cfg.ControlPlane.CoreDNS.Embedded = v.CoreDNS.Integrated
+	cfg.Networking.Advanced.ProxyKubelets.ByIP = v.Sync.Nodes.FakeKubeletIPs
+	return cfg
+}
+
+// configFeature is a vcluster.yaml feature toggle; vCluster enables these by default.
+type configFeature struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// featureEnabled reports whether an optional toggle is enabled, defaulting to true.
+func featureEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
+// SyncExpectations records which signals a vCluster's config expects.
+type SyncExpectations struct {
+	// Source names the config, e.g. Secret/vc-config-vc-prod; empty when none was found.
+	Source string
+	// disabled holds the signals whose feature the config turns off.
+	disabled map[string]bool
+}
+
+// Expected reports whether signal is expected. Without a config every signal is expected.
+func (e *SyncExpectations) Expected(signal string) bool {
+	return e == nil || !e.disabled[signal]
+}
+
+// expectationsFromConfig maps vcluster.yaml features to the signals that depend on them:
+//   - tenantWorkloadSync needs sync.toHost.pods;
+//   - systemWorkloadSync also needs CoreDNS running as a synced pod, i.e. not embedded;
+//   - dnsSync needs CoreDNS and sync.toHost.services for its kube-dns Service;
+//   - nodeSync needs networking.advanced.proxyKubelets.byIP, which creates the node Services, and
+//     node syncing, which only an explicit sync.fromHost.nodes.enabled: false turns off.
+func expectationsFromConfig(source string, cfg vclusterConfig) *SyncExpectations {
+	pods := featureEnabled(cfg.Sync.ToHost.Pods.Enabled)
+	coreDNS := featureEnabled(cfg.ControlPlane.CoreDNS.Enabled)
+	disabled := map[string]bool{
+		SignalTenantWorkloadSync: !pods,
+		SignalSystemWorkloadSync: !pods || !coreDNS || cfg.ControlPlane.CoreDNS.Embedded,
+		SignalDNSSync:            !coreDNS || !featureEnabled(cfg.Sync.ToHost.Services.Enabled),
+		SignalNodeSync:           !featureEnabled(cfg.Sync.FromHost.Nodes.Enabled) || !featureEnabled(cfg.Networking.Advanced.ProxyKubelets.ByIP),
+	}
+	return &SyncExpectations{Source: source, disabled: disabled}
+}
+
+// Expectations derives the signals c's config expects. vCluster ≥0.20 keeps its vcluster.yaml in
+// the Secret vc-config-<name>; without it, and for older versions, the values of the latest Helm
+// release are used, read in the legacy chart format when c.VClusterVersion (or the chart version)
+// predates vcluster.yaml. Without either every signal is expected. Secrets are read uncached
+// through apiReader, so they are never watched. The result is memoised, and nil unless the
+// controller runs with --read-vcluster-config.
+func (s *HostSnapshot) Expectations(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*SyncExpectations, error) {
+	if !s.readConfig {
+		return nil, nil
+	}
+	key := c.Namespace + "/" + c.Name
+	if e, ok := s.expectations[key]; ok {
+		return e, nil
+	}
+	e, err := s.readExpectations(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.expectations[key] = e
+	return e, nil
+}
+
+func (s *HostSnapshot) readExpectations(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*SyncExpectations, error) {
+	rel, err := s.HelmRelease(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	chartVersion := ""
+	if rel != nil {
+		chartVersion = rel.Chart.Metadata.Version
+	}
+	legacy := usesLegacyValues(c.VClusterVersion, chartVersion)
+
+	if !legacy {
+		e, err := s.readConfigSecret(ctx, c)
+		if e != nil || err != nil {
+			return e, err
+		}
+	}
+	if rel == nil || len(rel.Config) == 0 {
+		return &SyncExpectations{}, nil
+	}
+	source := fmt.Sprintf("HelmRelease/%s.v%d", rel.Name, rel.Version)
+	var cfg vclusterConfig
+	if legacy {
+		var values legacyValues
+		if err := yaml.Unmarshal(rel.Config, &values); err != nil {
+			return nil, fmt.Errorf("parsing values of %s: %w", source, err)
+		}
+		cfg = values.config()
+	} else if err := yaml.Unmarshal(rel.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing values of %s: %w", source, err)
+	}
+	return expectationsFromConfig(source, cfg), nil
+}
+
+// readConfigSecret reads vcluster.yaml from the Secret vc-config-<name>, or returns nil if it
+// does not exist.
+func (s *HostSnapshot) readConfigSecret(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*SyncExpectations, error) {
+	reader := s.uncached()
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: c.Namespace, Name: configSecretPrefix + c.Name}
+	if err := reader.Get(ctx, key, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading config Secret %s: %w", key, err)
+	}
+	data, ok := secret.Data[configSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("config Secret %s has no %q key", key, configSecretKey)
+	}
+	var cfg vclusterConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config Secret %s: %w", key, err)
+	}
+	return expectationsFromConfig("Secret/"+secret.Name, cfg), nil
+}
+
+// expectationsCondition reports whether expectations are derived from vCluster configs, which
+// needs --read-vcluster-config. Without it every signal is expected.
+func expectationsCondition(readConfig bool, generation int64) metav1.Condition {
+	if !readConfig {
+		return metav1.Condition{
+			Type:               fleetv1alpha1.ConditionExpectationsEvaluated,
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonCheckDisabled,
+			Message:            "the manager runs without --read-vcluster-config; every signal is expected",
+			ObservedGeneration: generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               fleetv1alpha1.ConditionExpectationsEvaluated,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ConfigRead",
+		Message:            "expected signals are derived from each vCluster's config",
+		ObservedGeneration: generation,
+	}
+}
+
+// compareExpectations lists, per signal, whether it is expected and observed.
+func compareExpectations(signals []fleetv1alpha1.SignalResult, e *SyncExpectations) []fleetv1alpha1.SignalExpectation {
+	out := make([]fleetv1alpha1.SignalExpectation, 0, len(signals))
+	for _, sig := range signals {
+		expected := e.Expected(sig.Name)
+		observed := sig.Status == metav1.ConditionTrue
+		out = append(out, fleetv1alpha1.SignalExpectation{
+			Name:               sig.Name,
+			Expected:           expected,
+			Observed:           observed,
+			UnexpectedlyActive: observed && !expected,
+		})
+	}
+	return out
+}
+
+// expectedSignals returns the signals e expects, the ones that are scored.
+func expectedSignals(signals []fleetv1alpha1.SignalResult, e *SyncExpectations) []fleetv1alpha1.SignalResult {
+	out := make([]fleetv1alpha1.SignalResult, 0, len(signals))
+	for _, sig := range signals {
+		if e.Expected(sig.Name) {
+			out = append(out, sig)
+		}
+	}
+	return out
+}
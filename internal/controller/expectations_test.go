@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("config expectations", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+
+	configSecret := func(config string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-config-vc-prod", Namespace: "vcluster"},
+			Data:       map[string][]byte{"config.yaml": []byte(config)},
+		}
+	}
+
+	It("expects every signal without a config Secret", func() {
+		e, err := newConfigSnapshot().Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Source).To(BeEmpty())
+		for _, d := range DefaultDetectorRegistry().Detectors() {
+			Expect(e.Expected(d.Name())).To(BeTrue(), d.Name())
+		}
+	})
+
+	It("derives expected signals from vcluster.yaml", func() {
+		e, err := newConfigSnapshot(configSecret(`
+sync:
+  toHost:
+    pods:
+      enabled: true
+controlPlane:
+  coredns:
+    embedded: true
+networking:
+  advanced:
+    proxyKubelets:
+      byIP: false
+`)).Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Source).To(Equal("Secret/vc-config-vc-prod"))
+		Expect(e.Expected(SignalTenantWorkloadSync)).To(BeTrue())
+		Expect(e.Expected(SignalDNSSync)).To(BeTrue())
+		Expect(e.Expected(SignalSystemWorkloadSync)).To(BeFalse(), "embedded CoreDNS runs no synced pod")
+		Expect(e.Expected(SignalNodeSync)).To(BeFalse())
+		Expect(e.Expected(SignalAPISync)).To(BeTrue())
+
+		e = expectationsFromConfig("", vclusterConfig{})
+		Expect(e.Expected(SignalNodeSync)).To(BeTrue(), "unset toggles keep the vCluster defaults")
+	})
+
+	It("derives node sync from sync.fromHost.nodes", func() {
+		e, err := newConfigSnapshot(configSecret(`
+sync:
+  fromHost:
+    nodes:
+      enabled: false
+`)).Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Expected(SignalNodeSync)).To(BeFalse())
+		Expect(e.Expected(SignalDNSSync)).To(BeTrue())
+
+		e, err = newConfigSnapshot(configSecret(`
+sync:
+  fromHost:
+    nodes:
+      enabled: true
+`)).Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Expected(SignalNodeSync)).To(BeTrue())
+
+		var cfg vclusterConfig
+		cfg.Sync.FromHost.Nodes.Enabled = ptr.To(true)
+		cfg.Networking.Advanced.ProxyKubelets.ByIP = ptr.To(false)
+		Expect(expectationsFromConfig("", cfg).Expected(SignalNodeSync)).To(BeFalse(), "synced nodes get no Services without byIP")
+
+		var values legacyValues
+		values.Sync.Nodes.Enabled = ptr.To(false)
+		Expect(expectationsFromConfig("", values.config()).Expected(SignalNodeSync)).To(BeFalse(), "legacy sync.nodes maps to sync.fromHost.nodes")
+	})
+
+	It("rejects a config Secret it cannot parse", func() {
+		_, err := newConfigSnapshot(configSecret("sync: [")).Expectations(ctx, cluster)
+		Expect(err).To(MatchError(ContainSubstring("parsing config Secret vcluster/vc-config-vc-prod")))
+	})
+
+	It("compares expected and observed signals and scores only expected ones", func() {
+		e := &SyncExpectations{disabled: map[string]bool{SignalNodeSync: true}}
+		signals := []fleetv1alpha1.SignalResult{
+			{Name: SignalAPISync, Status: metav1.ConditionTrue},
+			{Name: SignalNodeSync, Status: metav1.ConditionFalse},
+		}
+		Expect(compareExpectations(signals, e)).To(Equal([]fleetv1alpha1.SignalExpectation{
+			{Name: SignalAPISync, Expected: true, Observed: true},
+			{Name: SignalNodeSync, Expected: false, Observed: false},
+		}))
+		score, level := computeScoreLevel(expectedSignals(signals, e), nil)
+		Expect(score).To(BeEquivalentTo(100))
+		Expect(level).To(Equal("Full"))
+
+		signals[1].Status = metav1.ConditionTrue
+		Expect(compareExpectations(signals, e)[1].UnexpectedlyActive).To(BeTrue())
+	})
+
+	It("reports the config source and expectations in syncCoverage", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		svc := apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"})
+		slice := apiEndpointSlice("vc-prod", "vcluster", "", true)
+
+		got, err := reconcileFleet(vh.DeepCopy(), svc.DeepCopy(), slice.DeepCopy(), configSecret("sync:\n  toHost:\n    pods:\n      enabled: false\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.SyncCoverage[0].ConfigSource).To(BeEmpty(), "the config is only read with --read-vcluster-config")
+		cond := meta.FindStatusCondition(got.Status.Conditions, fleetv1alpha1.ConditionExpectationsEvaluated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("CheckDisabled"))
+
+		r := &VClusterHealthReconciler{ReadVClusterConfig: true}
+		got, err = reconcileFleetWith(r, vh.DeepCopy(), svc.DeepCopy(), slice.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.IsStatusConditionTrue(got.Status.Conditions, fleetv1alpha1.ConditionExpectationsEvaluated)).To(BeTrue())
+		baseline := got.Status.SyncCoverage[0]
+		Expect(baseline.ConfigSource).To(BeEmpty())
+
+		got, err = reconcileFleetWith(r, vh.DeepCopy(), svc.DeepCopy(), slice.DeepCopy(), configSecret("sync:\n  toHost:\n    pods:\n      enabled: false\n"))
+		Expect(err).NotTo(HaveOccurred())
+		cov := got.Status.SyncCoverage[0]
+		Expect(cov.ConfigSource).To(Equal("Secret/vc-config-vc-prod"))
+		Expect(cov.Expectations).To(ContainElement(fleetv1alpha1.SignalExpectation{Name: SignalTenantWorkloadSync, Expected: false, Observed: false}))
+		Expect(cov.Signals).To(HaveLen(len(baseline.Signals)), "unexpected signals are still evaluated")
+		Expect(cov.Score).To(BeNumerically(">", baseline.Score))
+	})
+})
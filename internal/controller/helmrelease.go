@@ -0,0 +1,211 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalReleaseHealthy reports whether the latest Helm release of a vCluster is deployed.
+const SignalReleaseHealthy = "releaseHealthy"
+
+// Helm release statuses, as stored in the release and in the Secret's status label.
+const (
+	releaseDeployed        = "deployed"
+	releaseFailed          = "failed"
+	releasePendingInstall  = "pending-install"
+	releasePendingUpgrade  = "pending-upgrade"
+	releasePendingRollback = "pending-rollback"
+)
+
+// DefaultReleasePendingTimeout is how long a release may stay pending before it counts as stuck.
+const DefaultReleasePendingTimeout = 15 * time.Minute
+
+// maxReleaseSize bounds the decompressed release; the rendered manifest is decoded but unused.
+const maxReleaseSize = 16 << 20
+
+// helmReleaseSelector matches the Secrets Helm stores a release named name in
+// (sh.helm.release.v1.<name>.v<revision>).
+func helmReleaseSelector(name string) client.MatchingLabels {
+	return client.MatchingLabels{"owner": "helm", "name": name}
+}
+
+// HelmReleaseState is the subset of a Helm release record the controller reads.
+type HelmReleaseState struct {
+	Name    string `json:"name"`
+	Version int32  `json:"version"`
+	Info    struct {
+		Status       string    `json:"status"`
+		LastDeployed time.Time `json:"last_deployed"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+	// Config holds the user-supplied chart values.
+	Config json.RawMessage `json:"config"`
+}
+
+// Status returns the release as reported in DiscoveredCluster.
+func (r *HelmReleaseState) Status() *fleetv1alpha1.HelmRelease {
+	st := &fleetv1alpha1.HelmRelease{
+		Chart:        r.Chart.Metadata.Name,
+		ChartVersion: r.Chart.Metadata.Version,
+		AppVersion:   r.Chart.Metadata.AppVersion,
+		Revision:     r.Version,
+		Status:       r.Info.Status,
+	}
+	if !r.Info.LastDeployed.IsZero() {
+		// The API server keeps seconds only; finer precision would make every status look changed.
+		st.LastDeployed = metav1.NewTime(r.Info.LastDeployed.Truncate(time.Second))
+	}
+	return st
+}
+
+// decodeHelmRelease decodes the release field of a Helm Secret: base64 of (optionally gzipped) JSON.
+func decodeHelmRelease(data []byte) (*HelmReleaseState, error) {
+	raw := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(raw, data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	raw = raw[:n]
+	if bytes.HasPrefix(raw, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing: %w", err)
+		}
+		if raw, err = io.ReadAll(io.LimitReader(zr, maxReleaseSize)); err != nil {
+			return nil, fmt.Errorf("decompressing: %w", err)
+		}
+	}
+	var rel HelmReleaseState
+	if err := json.Unmarshal(raw, &rel); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	return &rel, nil
+}
+
+// HelmRelease returns the latest Helm release named after c in c's namespace, or nil if the
+// vCluster was not installed with Helm. Release Secrets are listed as metadata and only the
+// latest revision is read, uncached through apiReader. The result is memoised.
+func (s *HostSnapshot) HelmRelease(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*HelmReleaseState, error) {
+	if !s.readConfig {
+		return nil, nil
+	}
+	key := c.Namespace + "/" + c.Name
+	if rel, ok := s.releases[key]; ok {
+		return rel, nil
+	}
+	rel, err := s.readHelmRelease(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.releases[key] = rel
+	return rel, nil
+}
+
+func (s *HostSnapshot) readHelmRelease(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*HelmReleaseState, error) {
+	reader := s.uncached()
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("SecretList"))
+	if err := reader.List(ctx, list, client.InNamespace(c.Namespace), helmReleaseSelector(c.Name)); err != nil {
+		return nil, fmt.Errorf("listing Helm release Secrets: %w", err)
+	}
+	latest, latestRev := "", -1
+	for _, item := range list.Items {
+		rev, err := strconv.Atoi(item.Labels["version"])
+		if err != nil {
+			continue
+		}
+		if rev > latestRev {
+			latest, latestRev = item.Name, rev
+		}
+	}
+	if latest == "" {
+		return nil, nil
+	}
+
+	var secret corev1.Secret
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: c.Namespace, Name: latest}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Pruned between list and get; the next reconcile sees the new revision.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading Helm release Secret %s/%s: %w", c.Namespace, latest, err)
+	}
+	rel, err := decodeHelmRelease(secret.Data["release"])
+	if err != nil {
+		return nil, fmt.Errorf("decoding Helm release Secret %s/%s: %w", c.Namespace, latest, err)
+	}
+	return rel, nil
+}
+
+// releaseDetector reports whether the latest Helm release is deployed. A failed release, or one
+// pending for longer than DefaultReleasePendingTimeout, is unhealthy; a vCluster without a
+// release is not penalised. Without --read-vcluster-config the check is reported as disabled.
+type releaseDetector struct{}
+
+func (releaseDetector) Name() string { return SignalReleaseHealthy }
+
+func (releaseDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	if !snap.readConfig {
+		return checkDisabled("the manager runs without --read-vcluster-config")
+	}
+	rel, err := snap.HelmRelease(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if rel == nil {
+		return DetectorResult{Status: metav1.ConditionTrue, Reason: "NotHelmManaged", Evidence: fmt.Sprintf("no Helm release %s in namespace %s", c.Name, c.Namespace)}
+	}
+	evidence := fmt.Sprintf("release %s revision %d (%s %s) is %s", rel.Name, rel.Version, rel.Chart.Metadata.Name, rel.Chart.Metadata.Version, rel.Info.Status)
+	switch rel.Info.Status {
+	case releaseDeployed:
+		return DetectorResult{Status: metav1.ConditionTrue, Reason: "ReleaseDeployed", Evidence: evidence}
+	case releaseFailed:
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "ReleaseFailed", Evidence: evidence}
+	case releasePendingInstall, releasePendingUpgrade, releasePendingRollback:
+		if !rel.Info.LastDeployed.IsZero() {
+			evidence += " since " + rel.Info.LastDeployed.UTC().Format(time.RFC3339)
+		}
+		if rel.Info.LastDeployed.IsZero() || time.Since(rel.Info.LastDeployed) > DefaultReleasePendingTimeout {
+			return DetectorResult{Status: metav1.ConditionFalse, Reason: "ReleaseStuck", Evidence: evidence}
+		}
+		return DetectorResult{Status: metav1.ConditionTrue, Reason: "ReleaseInProgress", Evidence: evidence}
+	default:
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "ReleaseNotDeployed", Evidence: evidence}
+	}
+}
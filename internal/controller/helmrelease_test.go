@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// helmSecret returns the Secret Helm stores revision rev of release vc-prod in.
+func helmSecret(rev int, status string, deployed time.Time) *corev1.Secret {
+	return helmReleaseSecret(map[string]any{
+		"name":    "vc-prod",
+		"version": rev,
+		"info":    map[string]any{"status": status, "last_deployed": deployed.Format(time.RFC3339Nano)},
+		"chart":   map[string]any{"metadata": map[string]any{"name": "vcluster", "version": "0.20.0", "appVersion": "0.20.0"}},
+	})
+}
+
+// helmReleaseSecret encodes a release record like Helm: gzipped JSON, base64-encoded into the release key.
+func helmReleaseSecret(record map[string]any) *corev1.Secret {
+	raw, err := json.Marshal(record)
+	Expect(err).NotTo(HaveOccurred())
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err = zw.Write(raw)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(zw.Close()).To(Succeed())
+
+	status := record["info"].(map[string]any)["status"].(string)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", record["name"], record["version"]),
+			Namespace: "vcluster",
+			Labels:    map[string]string{"owner": "helm", "name": record["name"].(string), "status": status, "version": fmt.Sprint(record["version"])},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))},
+	}
+}
+
+var _ = Describe("Helm release", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+	deployed := time.Date(2026, 1, 2, 3, 4, 5, 678, time.UTC)
+
+	It("decodes the latest revision", func() {
+		rel, err := newConfigSnapshot(
+			helmSecret(2, "superseded", deployed),
+			helmSecret(10, releaseDeployed, deployed),
+			helmSecret(9, "superseded", deployed),
+		).HelmRelease(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rel.Status()).To(Equal(&fleetv1alpha1.HelmRelease{
+			Chart: "vcluster", ChartVersion: "0.20.0", AppVersion: "0.20.0",
+			Revision: 10, Status: releaseDeployed, LastDeployed: metav1.NewTime(deployed.Truncate(time.Second)),
+		}), "lastDeployed keeps the API server's second precision, so unchanged releases are not rewritten")
+
+		res := releaseDetector{}.Evaluate(ctx, cluster, newConfigSnapshot(helmSecret(10, releaseDeployed, deployed)))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Evidence).To(Equal("release vc-prod revision 10 (vcluster 0.20.0) is deployed"))
+	})
+
+	It("decodes uncompressed releases", func() {
+		rel, err := decodeHelmRelease([]byte(base64.StdEncoding.EncodeToString([]byte(`{"name":"vc-prod","version":1,"info":{"status":"deployed"}}`))))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rel.Version).To(BeEquivalentTo(1))
+		Expect(rel.Info.Status).To(Equal(releaseDeployed))
+
+		_, err = decodeHelmRelease([]byte("not base64!"))
+		Expect(err).To(MatchError(ContainSubstring("decoding base64")))
+	})
+
+	It("reports failed and stuck releases", func() {
+		res := releaseDetector{}.Evaluate(ctx, cluster, newConfigSnapshot(helmSecret(1, releaseDeployed, deployed), helmSecret(2, releaseFailed, deployed)))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("ReleaseFailed"))
+
+		res = releaseDetector{}.Evaluate(ctx, cluster, newConfigSnapshot(helmSecret(3, releasePendingUpgrade, deployed)))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("ReleaseStuck"))
+		Expect(res.Evidence).To(Equal("release vc-prod revision 3 (vcluster 0.20.0) is pending-upgrade since 2026-01-02T03:04:05Z"))
+
+		res = releaseDetector{}.Evaluate(ctx, cluster, newConfigSnapshot(helmSecret(3, releasePendingUpgrade, time.Now().Add(-time.Minute))))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("ReleaseInProgress"))
+	})
+
+	It("does not penalise vClusters installed without Helm", func() {
+		res := releaseDetector{}.Evaluate(ctx, cluster, newConfigSnapshot())
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("NotHelmManaged"))
+	})
+
+	It("reports the release on the discovered cluster", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		got, err := reconcileFleetWith(&VClusterHealthReconciler{ReadVClusterConfig: true}, vh, apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"}), helmSecret(4, releaseFailed, deployed))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.Clusters).To(HaveLen(1))
+		Expect(got.Status.Clusters[0].Release).NotTo(BeNil())
+		Expect(got.Status.Clusters[0].Release.Status).To(Equal(releaseFailed))
+		Expect(got.Status.SyncCoverage[0].ReleaseHealthy).To(BeFalse())
+	})
+
+	It("reads neither releases nor config Secrets without --read-vcluster-config", func() {
+		snap := newTestSnapshot(helmSecret(4, releaseFailed, deployed))
+		rel, err := snap.HelmRelease(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rel).To(BeNil())
+		e, err := snap.Expectations(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e).To(BeNil(), "every signal is expected")
+
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec: fleetv1alpha1.VClusterHealthSpec{
+				Namespace: "vcluster",
+				Rules:     []fleetv1alpha1.HealthRule{{Name: "release", Expression: "releaseHealthy"}},
+			},
+		}
+		svc := apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"})
+		slice := apiEndpointSlice("vc-prod", "vcluster", "", true)
+		got, err := reconcileFleet(vh, svc, slice, helmSecret(4, releaseFailed, deployed))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.Clusters[0].Release).To(BeNil())
+		cov := got.Status.SyncCoverage[0]
+		Expect(cov.Signals).To(ContainElement(SatisfyAll(
+			HaveField("Name", SignalReleaseHealthy),
+			HaveField("Status", metav1.ConditionUnknown),
+			HaveField("Reason", "CheckDisabled"),
+		)))
+		Expect(cov.ReleaseHealthy).To(BeTrue(), "a disabled check does not report the release as failed")
+		Expect(cov.Rules).To(ConsistOf(HaveField("Passed", true)))
+
+		scored := cov.Signals[:0:0]
+		for _, s := range cov.Signals {
+			if s.Name != SignalReleaseHealthy {
+				scored = append(scored, s)
+			}
+		}
+		score, _ := computeScoreLevel(scored, nil)
+		Expect(cov.Score).To(Equal(score), "the disabled check is not scored")
+	})
+})
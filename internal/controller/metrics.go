@@ -0,0 +1,173 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// Metric labels: fleet is the VClusterHealth object as <namespace>/<name>; namespace and cluster
+// identify the vCluster by its host namespace and name.
+var (
+	signalGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_signal",
+		Help: "Whether a vCluster signal is True (1) or not (0).",
+	}, []string{"fleet", "namespace", "cluster", "signal"})
+
+	scoreGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_score",
+		Help: "vCluster health score from 0 to 100.",
+	}, []string{"fleet", "namespace", "cluster"})
+
+	levelGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_level",
+		Help: "vCluster health level, one-hot: 1 for the current level, 0 for the others.",
+	}, []string{"fleet", "namespace", "cluster", "level"})
+
+	discoveredGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_discovered_clusters",
+		Help: "Number of vClusters discovered by a fleet.",
+	}, []string{"fleet"})
+
+	outOfPolicyGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_out_of_policy_clusters",
+		Help: "Number of vClusters whose version violates the fleet's version policy.",
+	}, []string{"fleet"})
+
+	certificateExpiryGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_health_certificate_expiry_timestamp_seconds",
+		Help: "Unix time at which a vCluster's earliest-expiring certificate expires.",
+	}, []string{"fleet", "namespace", "cluster"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(signalGauge, scoreGauge, levelGauge, discoveredGauge, outOfPolicyGauge, certificateExpiryGauge)
+}
+
+type series struct {
+	labels []string
+	value  float64
+}
+
+// seriesSet is every series one fleet exports, by gauge and joined label values.
+type seriesSet map[*prometheus.GaugeVec]map[string]series
+
+func (s seriesSet) add(vec *prometheus.GaugeVec, value float64, labels ...string) {
+	if s[vec] == nil {
+		s[vec] = map[string]series{}
+	}
+	s[vec][strings.Join(labels, "\x00")] = series{labels: labels, value: value}
+}
+
+var (
+	exportedMu sync.Mutex
+	// exported holds the series last committed per fleet, so stale ones can be deleted.
+	exported = map[string]seriesSet{}
+)
+
+// fleetMetrics collects the series of one reconcile. commit exports them and deletes the
+// fleet's series that were not observed again (vanished clusters, signals or levels).
+type fleetMetrics struct {
+	fleet  string
+	levels []string
+	next   seriesSet
+}
+
+func newFleetMetrics(fleet string, policy *fleetv1alpha1.ScoringPolicy) *fleetMetrics {
+	levels := []string{"None", "Partial", "Full"}
+	if policy != nil && len(policy.Levels) > 0 {
+		levels = levels[:0]
+		for _, l := range policy.Levels {
+			levels = append(levels, l.Name)
+		}
+	}
+	return &fleetMetrics{fleet: fleet, levels: levels, next: seriesSet{}}
+}
+
+// observe records the signals, score, level and certificate expiry of one vCluster.
+func (m *fleetMetrics) observe(c fleetv1alpha1.DiscoveredCluster, cov fleetv1alpha1.SyncCoverage) {
+	for _, s := range cov.Signals {
+		v := 0.0
+		if s.Status == metav1.ConditionTrue {
+			v = 1
+		}
+		m.next.add(signalGauge, v, m.fleet, c.Namespace, c.Name, s.Name)
+	}
+	m.next.add(scoreGauge, float64(cov.Score), m.fleet, c.Namespace, c.Name)
+	if cov.Certificates != nil && cov.Certificates.NotAfter != nil {
+		m.next.add(certificateExpiryGauge, float64(cov.Certificates.NotAfter.Unix()), m.fleet, c.Namespace, c.Name)
+	}
+
+	levels := m.levels
+	if !slices.Contains(levels, cov.Level) {
+		// A rule may override the level with a name outside the scoring levels.
+		levels = append(slices.Clone(levels), cov.Level)
+	}
+	for _, l := range levels {
+		v := 0.0
+		if l == cov.Level {
+			v = 1
+		}
+		m.next.add(levelGauge, v, m.fleet, c.Namespace, c.Name, l)
+	}
+}
+
+// outOfPolicy records the number of vClusters violating the version policy.
+func (m *fleetMetrics) outOfPolicy(n int32) {
+	m.next.add(outOfPolicyGauge, float64(n), m.fleet)
+}
+
+// commit exports the observed series and the discovered count, and deletes stale series.
+func (m *fleetMetrics) commit(discovered int) {
+	m.next.add(discoveredGauge, float64(discovered), m.fleet)
+
+	exportedMu.Lock()
+	defer exportedMu.Unlock()
+	for vec, set := range m.next {
+		for _, s := range set {
+			vec.WithLabelValues(s.labels...).Set(s.value)
+		}
+	}
+	for vec, set := range exported[m.fleet] {
+		for key, s := range set {
+			if _, ok := m.next[vec][key]; !ok {
+				vec.DeleteLabelValues(s.labels...)
+			}
+		}
+	}
+	exported[m.fleet] = m.next
+}
+
+// forgetFleetMetrics deletes every series of a fleet, e.g. once the VClusterHealth object is gone.
+func forgetFleetMetrics(fleet string) {
+	exportedMu.Lock()
+	defer exportedMu.Unlock()
+	for vec, set := range exported[fleet] {
+		for _, s := range set {
+			vec.DeleteLabelValues(s.labels...)
+		}
+	}
+	delete(exported, fleet)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// fleetSeries returns the label sets vec currently exports for fleet.
+func fleetSeries(vec *prometheus.GaugeVec, fleet string) []map[string]string {
+	ch := make(chan prometheus.Metric, 256)
+	vec.Collect(ch)
+	close(ch)
+
+	var out []map[string]string
+	for m := range ch {
+		var pb dto.Metric
+		Expect(m.Write(&pb)).To(Succeed())
+		lbls := map[string]string{}
+		for _, l := range pb.GetLabel() {
+			lbls[l.GetName()] = l.GetValue()
+		}
+		if lbls["fleet"] == fleet {
+			out = append(out, lbls)
+		}
+	}
+	return out
+}
+
+var _ = Describe("fleet metrics", func() {
+	const fleet = "metrics/fleet"
+	AfterEach(func() { forgetFleetMetrics(fleet) })
+
+	prod := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster"}
+	dev := fleetv1alpha1.DiscoveredCluster{Name: "vc-dev", Namespace: "vcluster"}
+	coverage := func(level string, score int32, apiSync metav1.ConditionStatus) fleetv1alpha1.SyncCoverage {
+		return fleetv1alpha1.SyncCoverage{
+			Score: score,
+			Level: level,
+			Signals: []fleetv1alpha1.SignalResult{
+				{Name: SignalAPISync, Status: apiSync},
+				{Name: SignalDNSSync, Status: metav1.ConditionFalse},
+			},
+		}
+	}
+
+	It("exports signals, score, a one-hot level and the discovered count", func() {
+		m := newFleetMetrics(fleet, nil)
+		m.observe(prod, coverage("Partial", 50, metav1.ConditionTrue))
+		m.commit(1)
+
+		Expect(testutil.ToFloat64(signalGauge.WithLabelValues(fleet, "vcluster", "vc-prod", SignalAPISync))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(signalGauge.WithLabelValues(fleet, "vcluster", "vc-prod", SignalDNSSync))).To(Equal(0.0))
+		Expect(testutil.ToFloat64(scoreGauge.WithLabelValues(fleet, "vcluster", "vc-prod"))).To(Equal(50.0))
+		Expect(testutil.ToFloat64(levelGauge.WithLabelValues(fleet, "vcluster", "vc-prod", "Partial"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(levelGauge.WithLabelValues(fleet, "vcluster", "vc-prod", "Full"))).To(Equal(0.0))
+		Expect(fleetSeries(levelGauge, fleet)).To(HaveLen(3))
+		Expect(testutil.ToFloat64(discoveredGauge.WithLabelValues(fleet))).To(Equal(1.0))
+	})
+
+	It("uses custom level names and adds a rule-override level", func() {
+		policy := &fleetv1alpha1.ScoringPolicy{Levels: []fleetv1alpha1.LevelThreshold{{Name: "Healthy", MinScore: 80}, {Name: "Down"}}}
+		m := newFleetMetrics(fleet, policy)
+		m.observe(prod, coverage("Quarantined", 100, metav1.ConditionTrue))
+		m.commit(1)
+
+		levels := []string{}
+		for _, s := range fleetSeries(levelGauge, fleet) {
+			levels = append(levels, s["level"])
+		}
+		Expect(levels).To(ConsistOf("Healthy", "Down", "Quarantined"))
+		Expect(testutil.ToFloat64(levelGauge.WithLabelValues(fleet, "vcluster", "vc-prod", "Quarantined"))).To(Equal(1.0))
+	})
+
+	It("deletes series of vanished clusters and forgotten fleets", func() {
+		m := newFleetMetrics(fleet, nil)
+		m.observe(prod, coverage("Full", 100, metav1.ConditionTrue))
+		m.observe(dev, coverage("None", 0, metav1.ConditionFalse))
+		m.commit(2)
+		Expect(fleetSeries(scoreGauge, fleet)).To(HaveLen(2))
+
+		m = newFleetMetrics(fleet, nil)
+		m.observe(prod, coverage("Full", 100, metav1.ConditionTrue))
+		m.commit(1)
+		for _, vec := range []*prometheus.GaugeVec{signalGauge, scoreGauge, levelGauge} {
+			for _, s := range fleetSeries(vec, fleet) {
+				Expect(s["cluster"]).To(Equal("vc-prod"))
+			}
+		}
+		Expect(fleetSeries(scoreGauge, fleet)).To(HaveLen(1))
+
+		forgetFleetMetrics(fleet)
+		for _, vec := range []*prometheus.GaugeVec{signalGauge, scoreGauge, levelGauge, discoveredGauge} {
+			Expect(fleetSeries(vec, fleet)).To(BeEmpty())
+		}
+	})
+})
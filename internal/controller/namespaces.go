@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// namespaceMatcher decides which host namespaces a fleet covers.
+// Without spec.namespaceSelector and spec.namespacePatterns it falls back to spec.namespace
+// (see targetNamespace).
+type namespaceMatcher struct {
+	single string // the only covered namespace, or "" if more can match
+	all    bool
+
+	selector labels.Selector // nil if unset
+	include  []string        // positive patterns; empty means any name
+	exclude  []string
+}
+
+// newNamespaceMatcher validates the namespace selection in spec.
+func newNamespaceMatcher(spec fleetv1alpha1.VClusterHealthSpec) (*namespaceMatcher, error) {
+	if spec.NamespaceSelector == nil && len(spec.NamespacePatterns) == 0 {
+		ns, all := targetNamespace(spec)
+		if all {
+			return &namespaceMatcher{all: true}, nil
+		}
+		return &namespaceMatcher{single: ns}, nil
+	}
+
+	m := &namespaceMatcher{}
+	if spec.NamespaceSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("spec.namespaceSelector: %w", err)
+		}
+		m.selector = sel
+	}
+	for _, p := range spec.NamespacePatterns {
+		glob, negated := strings.CutPrefix(p, "!")
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("spec.namespacePatterns %q: %w", p, err)
+		}
+		if negated {
+			m.exclude = append(m.exclude, glob)
+		} else {
+			m.include = append(m.include, glob)
+		}
+	}
+	return m, nil
+}
+
+// usesSelection reports whether the fleet uses namespaceSelector or namespacePatterns.
+func (m *namespaceMatcher) usesSelection() bool {
+	return m.single == "" && !m.all
+}
+
+// needsLabels reports whether matches needs the namespace's labels.
+func (m *namespaceMatcher) needsLabels() bool {
+	return m.selector != nil
+}
+
+// matchesName reports whether name passes spec.namespace or spec.namespacePatterns.
+// The namespace selector is not evaluated.
+func (m *namespaceMatcher) matchesName(name string) bool {
+	switch {
+	case m.all:
+		return true
+	case m.single != "":
+		return name == m.single
+	}
+	for _, p := range m.exclude {
+		if ok, _ := path.Match(p, name); ok {
+			return false
+		}
+	}
+	if len(m.include) == 0 {
+		return true
+	}
+	for _, p := range m.include {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether the namespace with the given name and labels is covered.
+func (m *namespaceMatcher) matches(name string, nsLabels map[string]string) bool {
+	if !m.matchesName(name) {
+		return false
+	}
+	return m.selector == nil || m.selector.Matches(labels.Set(nsLabels))
+}
+
+// namespaceLabels returns the labels of every host namespace, by name.
+func namespaceLabels(ctx context.Context, c client.Reader) (map[string]map[string]string, error) {
+	var list corev1.NamespaceList
+	if err := c.List(ctx, &list); err != nil {
+		return nil, err
+	}
+	out := make(map[string]map[string]string, len(list.Items))
+	for _, ns := range list.Items {
+		out[ns.Name] = ns.Labels
+	}
+	return out, nil
+}
+
+// fleetsForNamespace maps a Namespace to every VClusterHealth that selects namespaces by label,
+// or by patterns matching its name, so newly labelled or created namespaces are picked up.
+func (r *VClusterHealthReconciler) fleetsForNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	var fleets fleetv1alpha1.VClusterHealthList
+	if err := r.List(ctx, &fleets); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list VClusterHealth for namespace event", "namespace", obj.GetName())
+		return nil
+	}
+
+	var reqs []reconcile.Request
+	for _, vh := range fleets.Items {
+		m, err := newNamespaceMatcher(vh.Spec)
+		if err != nil || !m.usesSelection() {
+			continue
+		}
+		if m.needsLabels() || m.matchesName(obj.GetName()) {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&vh)})
+		}
+	}
+	return reqs
+}
+
+// namespaceLabelsChanged passes Namespace creates and deletes, and updates that change labels.
+func namespaceLabelsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("namespace selection", func() {
+	tenant := map[string]string{"tenant": "true"}
+	namespace := func(name string, lbls map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: lbls}}
+	}
+
+	It("keeps the legacy spec.namespace behaviour when no selection is set", func() {
+		m, err := newNamespaceMatcher(fleetv1alpha1.VClusterHealthSpec{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.usesSelection()).To(BeFalse())
+		Expect(m.matches("vcluster", nil)).To(BeTrue())
+		Expect(m.matches("team-a", nil)).To(BeFalse())
+
+		m, err = newNamespaceMatcher(fleetv1alpha1.VClusterHealthSpec{Namespace: "*"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.matches("team-a", nil)).To(BeTrue())
+	})
+
+	It("matches glob patterns with exclusions and the label selector", func() {
+		m, err := newNamespaceMatcher(fleetv1alpha1.VClusterHealthSpec{
+			Namespace:         "ignored",
+			NamespacePatterns: []string{"team-*", "!team-sandbox"},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: tenant},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.matches("team-a", tenant)).To(BeTrue())
+		Expect(m.matches("team-a", nil)).To(BeFalse())
+		Expect(m.matches("team-sandbox", tenant)).To(BeFalse())
+		Expect(m.matches("ignored", tenant)).To(BeFalse())
+
+		m, err = newNamespaceMatcher(fleetv1alpha1.VClusterHealthSpec{NamespacePatterns: []string{"!kube-*"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.matches("team-a", nil)).To(BeTrue())
+		Expect(m.matches("kube-system", nil)).To(BeFalse())
+	})
+
+	It("rejects malformed patterns", func() {
+		_, err := newNamespaceMatcher(fleetv1alpha1.VClusterHealthSpec{NamespacePatterns: []string{"team-["}})
+		Expect(err).To(MatchError(ContainSubstring("spec.namespacePatterns")))
+
+		got, err := reconcileFleet(&fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{NamespacePatterns: []string{"team-["}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.FindStatusCondition(got.Status.Conditions, fleetv1alpha1.ConditionStalled).Reason).
+			To(Equal(ReasonInvalidNamespaceSelection))
+	})
+
+	It("discovers vClusters only in selected namespaces", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec: fleetv1alpha1.VClusterHealthSpec{
+				NamespacePatterns: []string{"team-*", "!team-sandbox"},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: tenant},
+			},
+		}
+		vcluster := map[string]string{"app": "vcluster"}
+		got, err := reconcileFleet(vh,
+			namespace("team-a", tenant), namespace("team-b", nil), namespace("team-sandbox", tenant), namespace("vcluster", tenant),
+			apiService("vc-a", "team-a", vcluster),
+			apiService("vc-b", "team-b", vcluster),
+			apiService("vc-sandbox", "team-sandbox", vcluster),
+			apiService("vc-legacy", "vcluster", vcluster),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.Clusters).To(HaveLen(1))
+		Expect(got.Status.Clusters[0].Name).To(Equal("vc-a"))
+	})
+
+	It("maps Namespace events to fleets that select namespaces", func() {
+		fleet := func(name string, spec fleetv1alpha1.VClusterHealthSpec) *fleetv1alpha1.VClusterHealth {
+			return &fleetv1alpha1.VClusterHealth{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Spec: spec}
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(
+				fleet("legacy", fleetv1alpha1.VClusterHealthSpec{Namespace: "*"}),
+				fleet("patterns", fleetv1alpha1.VClusterHealthSpec{NamespacePatterns: []string{"team-*"}}),
+				fleet("selector", fleetv1alpha1.VClusterHealthSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: tenant}}),
+			).
+			Build()
+		r := &VClusterHealthReconciler{Client: c}
+
+		Expect(r.fleetsForNamespace(context.Background(), namespace("team-a", nil))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "patterns"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "selector"}},
+		))
+		Expect(r.fleetsForNamespace(context.Background(), namespace("other", nil))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "selector"}},
+		))
+
+		p := namespaceLabelsChanged()
+		Expect(p.Update(event.UpdateEvent{ObjectOld: namespace("team-a", nil), ObjectNew: namespace("team-a", nil)})).To(BeFalse())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: namespace("team-a", nil), ObjectNew: namespace("team-a", tenant)})).To(BeTrue())
+	})
+
+	It("maps Pods in a labelled namespace to selector fleets", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(
+				namespace("team-a", tenant),
+				&fleetv1alpha1.VClusterHealth{
+					ObjectMeta: metav1.ObjectMeta{Name: "selector", Namespace: "default"},
+					Spec:       fleetv1alpha1.VClusterHealthSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: tenant}},
+				},
+			).
+			Build()
+		r := &VClusterHealthReconciler{Client: c}
+
+		pod := func(ns string) *corev1.Pod {
+			return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: ns}}
+		}
+		Expect(r.fleetsForObject(context.Background(), pod("team-a"))).To(HaveLen(1))
+		Expect(r.fleetsForObject(context.Background(), pod("team-b"))).To(BeEmpty())
+	})
+})
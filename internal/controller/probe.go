@@ -0,0 +1,269 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalAPIReachable is reported by the active API probe, registered when spec.probe is set.
+const SignalAPIReachable = "apiReachable"
+
+const (
+	// DefaultProbeTimeout bounds one vCluster's probe when spec.probe.timeoutSeconds is unset.
+	DefaultProbeTimeout = 5 * time.Second
+
+	// defaultKubeconfigSecretPrefix names the kubeconfig Secret the vCluster chart creates (vc-<name>).
+	defaultKubeconfigSecretPrefix = "vc-"
+	// kubeconfigSecretKey is the Secret key holding the kubeconfig.
+	kubeconfigSecretKey = "config"
+	// maxProbeBody caps how much of a probe response is read.
+	maxProbeBody = 64 << 10
+	// probeConcurrency bounds how many vClusters are probed at once, so a fleet of unreachable
+	// API servers costs one timeout per probeConcurrency vClusters rather than one per vCluster.
+	probeConcurrency = 8
+)
+
+// apiProber probes the API server of each vCluster through its host Service.
+type apiProber struct {
+	// reader reads kubeconfig Secrets. It should not be cached, so Secrets are not watched cluster-wide.
+	reader       client.Reader
+	timeout      time.Duration
+	secretPrefix string
+	// address returns the host:port to dial; tests point it at a local server.
+	address func(fleetv1alpha1.DiscoveredCluster) string
+}
+
+// newAPIProber returns a prober configured from spec.probe.
+func newAPIProber(reader client.Reader, spec *fleetv1alpha1.ProbeSpec) *apiProber {
+	p := &apiProber{
+		reader:       reader,
+		timeout:      DefaultProbeTimeout,
+		secretPrefix: defaultKubeconfigSecretPrefix,
+		address:      serviceAddress,
+	}
+	if spec.TimeoutSeconds != nil && *spec.TimeoutSeconds > 0 {
+		p.timeout = time.Duration(*spec.TimeoutSeconds) * time.Second
+	}
+	if spec.KubeconfigSecretPrefix != nil {
+		p.secretPrefix = *spec.KubeconfigSecretPrefix
+	}
+	return p
+}
+
+// serviceAddress is the in-cluster address of the vCluster API Service.
+func serviceAddress(c fleetv1alpha1.DiscoveredCluster) string {
+	return net.JoinHostPort(c.ServiceName+"."+c.Namespace+".svc", strconv.Itoa(int(c.ServicePort)))
+}
+
+// ProbeResult is the outcome of probing one vCluster.
+type ProbeResult struct {
+	// StatusCode is the HTTP status of GET /readyz, 0 if no response was received.
+	StatusCode int
+	// Latency is the /readyz round-trip time.
+	Latency time.Duration
+	// ServerVersion is the gitVersion from GET /version, if it answered.
+	ServerVersion string
+	// Authenticated is true when kubeconfig Secret credentials were used.
+	Authenticated bool
+	// Err is set when /readyz could not be reached.
+	Err error
+}
+
+// Status returns the result as reported in SyncCoverage.
+func (r *ProbeResult) Status() *fleetv1alpha1.ProbeStatus {
+	st := &fleetv1alpha1.ProbeStatus{
+		StatusCode:          int32(r.StatusCode),
+		LatencyMilliseconds: r.Latency.Milliseconds(),
+		ServerVersion:       r.ServerVersion,
+		Authenticated:       r.Authenticated,
+	}
+	if r.Err != nil {
+		st.Error = r.Err.Error()
+	}
+	return st
+}
+
+// probe calls /readyz and /version on c's API server within the probe timeout. Connection
+// failures are reported in the result; the error is only set when the kubeconfig Secret
+// could not be read or parsed.
+func (p *apiProber) probe(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*ProbeResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	cfg, authenticated, err := p.restConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	res := &ProbeResult{Authenticated: authenticated}
+
+	start := time.Now()
+	code, _, err := probeGet(ctx, httpClient, cfg.Host+"/readyz")
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Err = err
+		return res, nil
+	}
+	res.StatusCode = code
+
+	// /version is informational; a failure does not fail the probe.
+	if code, body, err := probeGet(ctx, httpClient, cfg.Host+"/version"); err == nil && code == http.StatusOK {
+		var info version.Info
+		if json.Unmarshal(body, &info) == nil {
+			res.ServerVersion = info.GitVersion
+		}
+	}
+	return res, nil
+}
+
+// restConfig builds the client config for c: the kubeconfig Secret's credentials pointed at the
+// host Service, or an anonymous config that skips certificate verification if there is no Secret.
+func (p *apiProber) restConfig(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*rest.Config, bool, error) {
+	host := "https://" + p.address(c)
+
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: c.Namespace, Name: p.secretPrefix + c.Name}
+	if err := p.reader.Get(ctx, key, &secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, fmt.Errorf("reading kubeconfig Secret %s: %w", key, err)
+		}
+		return &rest.Config{Host: host, Timeout: p.timeout, TLSClientConfig: rest.TLSClientConfig{Insecure: true}}, false, nil
+	}
+	data, ok := secret.Data[kubeconfigSecretKey]
+	if !ok {
+		return nil, false, fmt.Errorf("kubeconfig Secret %s has no %q key", key, kubeconfigSecretKey)
+	}
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing kubeconfig Secret %s: %w", key, err)
+	}
+	// The kubeconfig usually points at localhost; dial the host Service instead.
+	cfg.Host = host
+	cfg.Timeout = p.timeout
+	return cfg, true, nil
+}
+
+// probeGet performs a GET and returns the status code and (capped) body.
+func probeGet(ctx context.Context, c *http.Client, url string) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxProbeBody))
+	return resp.StatusCode, body, err
+}
+
+// Probe returns the memoised API probe result of c, or nil if spec.probe is not set.
+func (s *HostSnapshot) Probe(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*ProbeResult, error) {
+	if s.prober == nil {
+		return nil, nil
+	}
+	key := c.Namespace + "/" + c.Name
+	if err, ok := s.probeErrs[key]; ok {
+		return nil, err
+	}
+	if res, ok := s.probes[key]; ok {
+		return res, nil
+	}
+	res, err := s.prober.probe(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.probes[key] = res
+	return res, nil
+}
+
+// probeAll probes clusters concurrently, at most probeConcurrency at a time, and memoises the
+// results and errors for Probe. It does nothing if spec.probe is not set.
+func (s *HostSnapshot) probeAll(ctx context.Context, clusters []fleetv1alpha1.DiscoveredCluster) {
+	if s.prober == nil {
+		return
+	}
+	results := make([]*ProbeResult, len(clusters))
+	errs := make([]error, len(clusters))
+	sem := make(chan struct{}, probeConcurrency)
+	var wg sync.WaitGroup
+	for i, c := range clusters {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i], errs[i] = s.prober.probe(ctx, c)
+		})
+	}
+	wg.Wait()
+	for i, c := range clusters {
+		key := c.Namespace + "/" + c.Name
+		if errs[i] != nil {
+			s.probeErrs[key] = errs[i]
+			continue
+		}
+		s.probes[key] = results[i]
+	}
+}
+
+// apiProbeDetector reports whether the vCluster API server answers /readyz with 200.
+type apiProbeDetector struct{}
+
+func (apiProbeDetector) Name() string { return SignalAPIReachable }
+
+func (apiProbeDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	res, err := snap.Probe(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	if res == nil {
+		return DetectorResult{Status: metav1.ConditionUnknown, Reason: "ProbeDisabled", Evidence: "spec.probe is not set"}
+	}
+	if res.Err != nil {
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "Unreachable", Evidence: res.Err.Error()}
+	}
+	evidence := fmt.Sprintf("GET /readyz returned %d", res.StatusCode)
+	if res.ServerVersion != "" {
+		evidence += ", server version " + res.ServerVersion
+	}
+	if res.StatusCode != http.StatusOK {
+		return DetectorResult{Status: metav1.ConditionFalse, Reason: "NotReady", Evidence: evidence}
+	}
+	return DetectorResult{Status: metav1.ConditionTrue, Reason: "Ready", Evidence: evidence}
+}
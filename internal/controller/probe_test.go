@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("active API probe", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+	const token = "probe-token"
+
+	var (
+		srv       *httptest.Server
+		readyCode int
+		hang      bool
+		sawToken  bool
+	)
+	BeforeEach(func() {
+		readyCode, hang, sawToken = http.StatusOK, false, false
+		mux := http.NewServeMux()
+		mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+			if hang {
+				<-r.Context().Done()
+				return
+			}
+			sawToken = r.Header.Get("Authorization") == "Bearer "+token
+			w.WriteHeader(readyCode)
+		})
+		mux.HandleFunc("/version", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"gitVersion":"v1.31.1+k3s1"}`))
+		})
+		srv = httptest.NewTLSServer(mux)
+		DeferCleanup(srv.Close)
+	})
+
+	// snapshotProbing returns a snapshot whose prober dials srv, reading Secrets from objs.
+	snapshotProbing := func(objs ...client.Object) *HostSnapshot {
+		c := fake.NewClientBuilder().WithScheme(newFakeScheme()).WithObjects(objs...).Build()
+		snap := NewHostSnapshot(c)
+		snap.prober = newAPIProber(c, &fleetv1alpha1.ProbeSpec{})
+		snap.prober.address = func(fleetv1alpha1.DiscoveredCluster) string { return srv.Listener.Addr().String() }
+		return snap
+	}
+
+	// kubeconfigSecret returns the vc-<name> Secret trusting srv's certificate, with a bearer token.
+	kubeconfigSecret := func() *corev1.Secret {
+		ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		data, err := clientcmd.Write(clientcmdapi.Config{
+			Clusters:       map[string]*clientcmdapi.Cluster{"vc": {Server: "https://localhost:8443", CertificateAuthorityData: ca}},
+			AuthInfos:      map[string]*clientcmdapi.AuthInfo{"vc": {Token: token}},
+			Contexts:       map[string]*clientcmdapi.Context{"vc": {Cluster: "vc", AuthInfo: "vc"}},
+			CurrentContext: "vc",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-vc-prod", Namespace: "vcluster"},
+			Data:       map[string][]byte{kubeconfigSecretKey: data},
+		}
+	}
+
+	It("probes /readyz and /version with the kubeconfig Secret's credentials", func() {
+		snap := snapshotProbing(kubeconfigSecret())
+		res := apiProbeDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("Ready"))
+		Expect(res.Evidence).To(Equal("GET /readyz returned 200, server version v1.31.1+k3s1"))
+		Expect(sawToken).To(BeTrue())
+
+		probe, err := snap.Probe(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		st := probe.Status()
+		Expect(st.StatusCode).To(Equal(int32(http.StatusOK)))
+		Expect(st.ServerVersion).To(Equal("v1.31.1+k3s1"))
+		Expect(st.Authenticated).To(BeTrue())
+	})
+
+	It("probes anonymously without a Secret and reports a failing /readyz", func() {
+		readyCode = http.StatusServiceUnavailable
+		res := apiProbeDetector{}.Evaluate(ctx, cluster, snapshotProbing())
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("NotReady"))
+		Expect(res.Evidence).To(HavePrefix("GET /readyz returned 503"))
+		Expect(sawToken).To(BeFalse())
+	})
+
+	It("gives up after the probe timeout", func() {
+		snap := snapshotProbing()
+		snap.prober.timeout = 100 * time.Millisecond
+		hang = true
+
+		start := time.Now()
+		res := apiProbeDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("Unreachable"))
+	})
+
+	It("probes a fleet concurrently", func() {
+		snap := snapshotProbing()
+		snap.prober.timeout = 200 * time.Millisecond
+		hang = true
+
+		clusters := make([]fleetv1alpha1.DiscoveredCluster, 4*probeConcurrency)
+		for i := range clusters {
+			clusters[i] = cluster
+			clusters[i].Name = fmt.Sprintf("vc-%d", i)
+		}
+		start := time.Now()
+		snap.probeAll(ctx, clusters)
+		Expect(time.Since(start)).To(BeNumerically("<", 2*time.Second), "%d sequential probes would take 6.4s", len(clusters))
+		for _, c := range clusters {
+			res, err := snap.Probe(ctx, c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Err).To(HaveOccurred(), c.Name)
+		}
+	})
+
+	It("only registers the probe when spec.probe is set", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		svc := apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"})
+		got, err := reconcileFleet(vh, svc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signalTrue(got.Status.SyncCoverage[0].Signals, SignalAPIReachable)).To(BeFalse())
+		Expect(got.Status.SyncCoverage[0].Signals).NotTo(ContainElement(HaveField("Name", SignalAPIReachable)))
+
+		vh.Spec.Probe = &fleetv1alpha1.ProbeSpec{TimeoutSeconds: ptr.To[int32](1)}
+		got, err = reconcileFleet(vh, svc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.SyncCoverage[0].Signals).To(ContainElement(HaveField("Name", SignalAPIReachable)))
+		Expect(got.Status.SyncCoverage[0].Probe).NotTo(BeNil())
+		Expect(got.Status.SyncCoverage[0].Probe.Error).NotTo(BeEmpty(), "the Service name does not resolve here")
+	})
+})
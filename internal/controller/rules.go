@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// compiledRule is a spec.rules entry ready to evaluate.
+type compiledRule struct {
+	rule    fleetv1alpha1.HealthRule
+	program cel.Program
+}
+
+// ruleInput is the per-vCluster data exposed to rule expressions.
+type ruleInput struct {
+	Coverage fleetv1alpha1.SyncCoverage
+	Cluster  fleetv1alpha1.DiscoveredCluster
+	Labels   map[string]string
+	Age      time.Duration
+}
+
+// newRuleEnv declares the variables documented on fleetv1alpha1.HealthRule.
+func newRuleEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(SignalAPISync, cel.BoolType),
+		cel.Variable(SignalControlPlaneReady, cel.BoolType),
+		cel.Variable(SignalControlPlaneStable, cel.BoolType),
+		cel.Variable(SignalBackingStoreHealthy, cel.BoolType),
+		cel.Variable(SignalReleaseHealthy, cel.BoolType),
+		cel.Variable(SignalDNSSync, cel.BoolType),
+		cel.Variable(SignalNodeSync, cel.BoolType),
+		cel.Variable("workloadSync", cel.BoolType),
+		cel.Variable(SignalSystemWorkloadSync, cel.BoolType),
+		cel.Variable(SignalTenantWorkloadSync, cel.BoolType),
+		cel.Variable("signals", cel.MapType(cel.StringType, cel.BoolType)),
+		cel.Variable("score", cel.IntType),
+		cel.Variable("level", cel.StringType),
+		cel.Variable("name", cel.StringType),
+		cel.Variable("namespace", cel.StringType),
+		cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("age", cel.DurationType),
+	)
+}
+
+// compileRules compiles every rule. Rules that fail to compile are skipped and
+// described in the returned error messages, one per rule.
+func compileRules(rules []fleetv1alpha1.HealthRule) ([]compiledRule, []string) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	env, err := newRuleEnv()
+	if err != nil {
+		return nil, []string{fmt.Sprintf("building CEL environment: %v", err)}
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	var errs []string
+	for _, rule := range rules {
+		ast, iss := env.Compile(rule.Expression)
+		if iss.Err() != nil {
+			errs = append(errs, fmt.Sprintf("rule %q: %v", rule.Name, iss.Err()))
+			continue
+		}
+		if ast.OutputType() != cel.BoolType {
+			errs = append(errs, fmt.Sprintf("rule %q: expression must return bool, got %s", rule.Name, ast.OutputType()))
+			continue
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %q: %v", rule.Name, err))
+			continue
+		}
+		compiled = append(compiled, compiledRule{rule: rule, program: prg})
+	}
+	return compiled, errs
+}
+
+// evaluateRules runs the compiled rules against one vCluster. It returns one result per rule
+// and the level of the first failing rule that sets one ("" if none).
+func evaluateRules(rules []compiledRule, in ruleInput) ([]fleetv1alpha1.RuleResult, string) {
+	if len(rules) == 0 {
+		return nil, ""
+	}
+	vars := ruleVars(in)
+
+	results := make([]fleetv1alpha1.RuleResult, 0, len(rules))
+	override := ""
+	for _, cr := range rules {
+		res := fleetv1alpha1.RuleResult{Name: cr.rule.Name}
+		out, _, err := cr.program.Eval(vars)
+		if err != nil {
+			res.Message = err.Error()
+		} else if passed, ok := out.Value().(bool); ok {
+			res.Passed = passed
+		} else {
+			res.Message = fmt.Sprintf("expression returned %s, not bool", out.Type())
+		}
+		if !res.Passed && override == "" && cr.rule.Level != "" {
+			override = cr.rule.Level
+		}
+		results = append(results, res)
+	}
+	return results, override
+}
+
+// ruleVars builds the CEL activation for one vCluster.
+func ruleVars(in ruleInput) map[string]any {
+	signals := make(map[string]bool, len(in.Coverage.Signals))
+	for _, s := range in.Coverage.Signals {
+		signals[s.Name] = s.Status == metav1.ConditionTrue
+	}
+	labels := in.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	return map[string]any{
+		SignalAPISync:             in.Coverage.ApiSync,
+		SignalControlPlaneReady:   in.Coverage.ControlPlaneReady,
+		SignalControlPlaneStable:  in.Coverage.ControlPlaneStable,
+		SignalBackingStoreHealthy: in.Coverage.BackingStoreHealthy,
+		SignalReleaseHealthy:      in.Coverage.ReleaseHealthy,
+		SignalDNSSync:             in.Coverage.DnsSync,
+		SignalNodeSync:            in.Coverage.NodeSync,
+		"workloadSync":            in.Coverage.WorkloadSync,
+		SignalSystemWorkloadSync:  in.Coverage.SystemWorkloadSync,
+		SignalTenantWorkloadSync:  in.Coverage.TenantWorkloadSync,
+		"signals":                 signals,
+		"score":                   int64(in.Coverage.Score),
+		"level":                   in.Coverage.Level,
+		"name":                    in.Cluster.Name,
+		"namespace":               in.Cluster.Namespace,
+		"labels":                  labels,
+		"age":                     in.Age,
+	}
+}
+
+// rulesCondition summarises rule compilation as the RulesValid condition.
+func rulesCondition(errs []string, generation int64) metav1.Condition {
+	if len(errs) > 0 {
+		return metav1.Condition{
+			Type:               fleetv1alpha1.ConditionRulesValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             "RuleCompileFailed",
+			Message:            strings.Join(errs, "; "),
+			ObservedGeneration: generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               fleetv1alpha1.ConditionRulesValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RulesCompiled",
+		Message:            "all rules compiled",
+		ObservedGeneration: generation,
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("CEL health rules", func() {
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster"}
+	coverage := fleetv1alpha1.SyncCoverage{
+		ClusterName:       "vc-prod",
+		ControlPlaneReady: true,
+		Signals: []fleetv1alpha1.SignalResult{
+			{Name: SignalControlPlaneReady, Status: metav1.ConditionTrue},
+			{Name: "custom", Status: metav1.ConditionFalse},
+		},
+		Score: 50,
+		Level: "Partial",
+	}
+
+	It("passes young clusters without tenant workloads", func() {
+		rules, errs := compileRules([]fleetv1alpha1.HealthRule{
+			{Name: "young-or-busy", Expression: "controlPlaneReady && (tenantWorkloadSync || age < duration('1h'))"},
+		})
+		Expect(errs).To(BeEmpty())
+
+		results, override := evaluateRules(rules, ruleInput{Coverage: coverage, Cluster: cluster, Age: 10 * time.Minute})
+		Expect(results).To(ConsistOf(fleetv1alpha1.RuleResult{Name: "young-or-busy", Passed: true}))
+		Expect(override).To(BeEmpty())
+
+		results, _ = evaluateRules(rules, ruleInput{Coverage: coverage, Cluster: cluster, Age: 2 * time.Hour})
+		Expect(results[0].Passed).To(BeFalse())
+	})
+
+	It("overrides the level with the first failing rule that sets one", func() {
+		rules, errs := compileRules([]fleetv1alpha1.HealthRule{
+			{Name: "score", Expression: "score >= 50", Level: "Ignored"},
+			{Name: "custom", Expression: "signals['custom']", Level: "Critical"},
+			{Name: "labels", Expression: "labels['tier'] == 'gold'", Level: "Later"},
+		})
+		Expect(errs).To(BeEmpty())
+
+		in := ruleInput{Coverage: coverage, Cluster: cluster, Labels: map[string]string{"tier": "silver"}}
+		results, override := evaluateRules(rules, in)
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Passed).To(BeTrue())
+		Expect(results[1].Passed).To(BeFalse())
+		Expect(override).To(Equal("Critical"))
+	})
+
+	It("records evaluation errors as failed results", func() {
+		rules, errs := compileRules([]fleetv1alpha1.HealthRule{
+			{Name: "missing-key", Expression: "labels['absent'] == 'x'"},
+		})
+		Expect(errs).To(BeEmpty())
+
+		results, _ := evaluateRules(rules, ruleInput{Coverage: coverage, Cluster: cluster})
+		Expect(results[0].Passed).To(BeFalse())
+		Expect(results[0].Message).NotTo(BeEmpty())
+	})
+
+	It("skips rules that do not compile or do not return bool", func() {
+		rules, errs := compileRules([]fleetv1alpha1.HealthRule{
+			{Name: "ok", Expression: "apiSync"},
+			{Name: "syntax", Expression: "apiSync &&"},
+			{Name: "not-bool", Expression: "score + 1"},
+		})
+		Expect(rules).To(HaveLen(1))
+		Expect(errs).To(HaveLen(2))
+
+		cond := rulesCondition(errs, 3)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("RuleCompileFailed"))
+		Expect(cond.Message).To(ContainSubstring(`rule "syntax"`))
+		Expect(cond.Message).To(ContainSubstring(`rule "not-bool"`))
+		Expect(cond.ObservedGeneration).To(Equal(int64(3)))
+	})
+})
@@ -0,0 +1,264 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// indexPodVClusterOwner indexes Pods by the vCluster named in any of vclusterOwnerLabelKeys.
+const indexPodVClusterOwner = "vcluster.loft.sh/owner-name"
+
+// indexPodRelease indexes Pods by their release label. The vCluster chart sets release=<vcluster name>
+// on control-plane pods, so the default control-plane lookup does not scan the whole namespace.
+const indexPodRelease = "vcluster.loft.sh/release"
+
+// vclusterOwnerLabelKeys are the labels vCluster uses to mark synced objects (varies by version/config).
+var vclusterOwnerLabelKeys = []string{
+	"vcluster.loft.sh/managed-by",
+	"vcluster.loft.sh/vcluster-name",
+	"vcluster.loft.sh/cluster",
+	"vcluster.loft.sh/owner",
+}
+
+// podVClusterOwners is the index function for indexPodVClusterOwner.
+func podVClusterOwners(obj client.Object) []string {
+	lbls := obj.GetLabels()
+	if len(lbls) == 0 {
+		return nil
+	}
+	var owners []string
+	for _, k := range vclusterOwnerLabelKeys {
+		if v := lbls[k]; v != "" && !slices.Contains(owners, v) {
+			owners = append(owners, v)
+		}
+	}
+	return owners
+}
+
+// podRelease is the index function for indexPodRelease.
+func podRelease(obj client.Object) []string {
+	if v := obj.GetLabels()[labelRelease]; v != "" {
+		return []string{v}
+	}
+	return nil
+}
+
+// SetupIndexes registers the cache indexes HostSnapshot relies on.
+// Lookups by namespace and by namespace/name use the cache's built-in indexes.
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners); err != nil {
+		return err
+	}
+	return indexer.IndexField(ctx, &corev1.Pod{}, indexPodRelease, podRelease)
+}
+
+// HostSnapshot is the host-side state a detector evaluates a vCluster against.
+// It is created once per reconcile and shared by every detector. Lookups go through
+// the reader's indexes and are memoised, so detectors only touch the objects of one vCluster.
+type HostSnapshot struct {
+	reader client.Reader
+
+	// controlPlaneSelector finds control-plane pods; set from spec.controlPlane by the reconciler.
+	controlPlaneSelector controlPlaneSelector
+	// stability judges control-plane containers; set from spec.controlPlane by the reconciler.
+	stability stabilityPolicy
+	// apiReader reads Secrets uncached; reader is used when nil.
+	apiReader client.Reader
+	// readConfig enables the Helm release and vc-config Secret reads; set by --read-vcluster-config.
+	readConfig bool
+	// versionPolicy checks vCluster versions; nil unless spec.versionPolicy is set.
+	versionPolicy *versionPolicy
+	// certificates checks certificate Secrets; nil unless spec.certificates is set.
+	certificates *certificatePolicy
+	// prober runs the active API probe; nil unless spec.probe is set.
+	prober *apiProber
+	// volumeStats reports volume usage; nil unless the controller runs with --volume-stats.
+	volumeStats VolumeStatsSource
+	// capacityThreshold is the volume usage percentage that flags the backing store.
+	capacityThreshold int32
+	// minHealthyPercent is the share of synced pods that must be healthy for a workload signal.
+	minHealthyPercent int32
+
+	services       map[string][]corev1.Service
+	endpointSlices map[string][]discoveryv1.EndpointSlice
+	ownedPods      map[string][]corev1.Pod
+	controlPlanes  map[string]*ControlPlaneState
+	probes         map[string]*ProbeResult
+	probeErrs      map[string]error
+	backingStores  map[string]*BackingStoreState
+	workloads      map[string]*WorkloadState
+	expectations   map[string]*SyncExpectations
+	releases       map[string]*HelmReleaseState
+	certs          map[string]*CertificateState
+	nodeStats      map[string]map[types.NamespacedName]VolumeUsage
+
+	svcErr error
+	epErr  error
+	podErr error
+	wlErr  error
+	volErr error
+}
+
+// NewHostSnapshot returns a snapshot reading from reader, typically the manager's cached client.
+// Pod lookups by vCluster require the index registered by SetupIndexes.
+func NewHostSnapshot(reader client.Reader) *HostSnapshot {
+	return &HostSnapshot{
+		reader:               reader,
+		controlPlaneSelector: defaultControlPlaneSelector,
+		stability:            defaultStabilityPolicy,
+		services:             map[string][]corev1.Service{},
+		endpointSlices:       map[string][]discoveryv1.EndpointSlice{},
+		ownedPods:            map[string][]corev1.Pod{},
+		controlPlanes:        map[string]*ControlPlaneState{},
+		probes:               map[string]*ProbeResult{},
+		probeErrs:            map[string]error{},
+		backingStores:        map[string]*BackingStoreState{},
+		workloads:            map[string]*WorkloadState{},
+		expectations:         map[string]*SyncExpectations{},
+		releases:             map[string]*HelmReleaseState{},
+		certs:                map[string]*CertificateState{},
+		nodeStats:            map[string]map[types.NamespacedName]VolumeUsage{},
+		capacityThreshold:    DefaultCapacityThresholdPercent,
+		minHealthyPercent:    DefaultMinHealthyPercent,
+	}
+}
+
+// uncached returns the reader for objects that are not cached.
+func (s *HostSnapshot) uncached() client.Reader {
+	if s.apiReader != nil {
+		return s.apiReader
+	}
+	return s.reader
+}
+
+// Services returns the Services in namespace.
+func (s *HostSnapshot) Services(ctx context.Context, namespace string) ([]corev1.Service, error) {
+	if svcs, ok := s.services[namespace]; ok {
+		return svcs, nil
+	}
+	var list corev1.ServiceList
+	if err := s.reader.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		s.recordServiceErr(err)
+		return nil, err
+	}
+	s.services[namespace] = list.Items
+	return list.Items, nil
+}
+
+// EndpointSlices returns the EndpointSlices backing the named Service.
+func (s *HostSnapshot) EndpointSlices(ctx context.Context, namespace, service string) ([]discoveryv1.EndpointSlice, error) {
+	key := namespace + "/" + service
+	if eps, ok := s.endpointSlices[key]; ok {
+		return eps, nil
+	}
+	var list discoveryv1.EndpointSliceList
+	if err := s.reader.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels{discoveryv1.LabelServiceName: service}); err != nil {
+		s.recordEndpointSliceErr(err)
+		return nil, err
+	}
+	s.endpointSlices[key] = list.Items
+	return list.Items, nil
+}
+
+// Pod returns the named Pod, or nil if it does not exist.
+func (s *HostSnapshot) Pod(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
+	var pod corev1.Pod
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		s.recordPodErr(err)
+		return nil, err
+	}
+	return &pod, nil
+}
+
+// PodsForVCluster returns the Pods labelled as owned by the vCluster vclusterName in namespace,
+// where it syncs them (see inVClusterNamespace). Same-named vClusters in other namespaces label
+// their pods alike, so the namespace tells them apart.
+func (s *HostSnapshot) PodsForVCluster(ctx context.Context, namespace, vclusterName string) ([]corev1.Pod, error) {
+	key := namespace + "/" + vclusterName
+	if pods, ok := s.ownedPods[key]; ok {
+		return pods, nil
+	}
+	var list corev1.PodList
+	if err := s.reader.List(ctx, &list, client.MatchingFields{indexPodVClusterOwner: vclusterName}); err != nil {
+		s.recordPodErr(err)
+		return nil, err
+	}
+	pods := slices.DeleteFunc(list.Items, func(p corev1.Pod) bool { return !inVClusterNamespace(&p, vclusterName, namespace) })
+	s.ownedPods[key] = pods
+	return pods, nil
+}
+
+// Err returns the first lookup error and the Stalled reason it maps to.
+func (s *HostSnapshot) Err() (string, error) {
+	if s.svcErr != nil {
+		return ReasonListServicesFailed, s.svcErr
+	}
+	if s.epErr != nil {
+		return ReasonListEndpointSlicesFailed, s.epErr
+	}
+	if s.podErr != nil {
+		return ReasonListPodsFailed, s.podErr
+	}
+	if s.wlErr != nil {
+		return ReasonGetWorkloadFailed, s.wlErr
+	}
+	if s.volErr != nil {
+		return ReasonGetVolumeClaimFailed, s.volErr
+	}
+	return "", nil
+}
+
+func (s *HostSnapshot) recordServiceErr(err error) {
+	if s.svcErr == nil {
+		s.svcErr = err
+	}
+}
+
+func (s *HostSnapshot) recordEndpointSliceErr(err error) {
+	if s.epErr == nil {
+		s.epErr = err
+	}
+}
+
+func (s *HostSnapshot) recordPodErr(err error) {
+	if s.podErr == nil {
+		s.podErr = err
+	}
+}
+
+func (s *HostSnapshot) recordWorkloadErr(err error) {
+	if s.wlErr == nil {
+		s.wlErr = err
+	}
+}
+
+func (s *HostSnapshot) recordVolumeErr(err error) {
+	if s.volErr == nil {
+		s.volErr = err
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// Run with: go test ./internal/controller -run '^$' -bench Snapshot -benchmem
+
+// indexerReader is a minimal client.Reader over client-go indexers, the same store the
+// controller-runtime informer cache uses. The fake client filters field selectors linearly,
+// so it cannot show the cost of indexed lookups. Like the cache, it narrows by one index and
+// then filters by label selector; the synthetic fleet has no workloads, so other Gets are NotFound.
+type indexerReader struct {
+	pods     toolscache.Indexer
+	services toolscache.Indexer
+}
+
+func newIndexerReader(pods []corev1.Pod, services []corev1.Service) *indexerReader {
+	podIndexers := toolscache.Indexers{
+		toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc,
+		indexPodVClusterOwner: func(obj any) ([]string, error) {
+			return podVClusterOwners(obj.(client.Object)), nil
+		},
+		// Namespaced like the cache's field indexes: <namespace>/<value>.
+		indexPodRelease: func(obj any) ([]string, error) {
+			o := obj.(client.Object)
+			var keys []string
+			for _, v := range podRelease(o) {
+				keys = append(keys, o.GetNamespace()+"/"+v)
+			}
+			return keys, nil
+		},
+	}
+	r := &indexerReader{
+		pods:     toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc, podIndexers),
+		services: toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc, toolscache.Indexers{toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc}),
+	}
+	for i := range pods {
+		_ = r.pods.Add(&pods[i])
+	}
+	for i := range services {
+		_ = r.services.Add(&services[i])
+	}
+	return r
+}
+
+func (r *indexerReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+	}
+	item, exists, err := r.pods.GetByKey(key.String())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+	}
+	item.(*corev1.Pod).DeepCopyInto(pod)
+	return nil
+}
+
+func (r *indexerReader) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	lo := client.ListOptions{}
+	lo.ApplyOptions(opts)
+
+	byIndex := func(idx toolscache.Indexer) ([]any, error) {
+		var items []any
+		var err error
+		switch {
+		case lo.FieldSelector != nil:
+			req := lo.FieldSelector.Requirements()[0]
+			value := req.Value
+			if req.Field == indexPodRelease {
+				value = lo.Namespace + "/" + value
+			}
+			items, err = idx.ByIndex(req.Field, value)
+		case lo.Namespace != "":
+			items, err = idx.ByIndex(toolscache.NamespaceIndex, lo.Namespace)
+		default:
+			items = idx.List()
+		}
+		if err != nil || lo.LabelSelector == nil {
+			return items, err
+		}
+		matched := items[:0:0]
+		for _, it := range items {
+			if lo.LabelSelector.Matches(labels.Set(it.(client.Object).GetLabels())) {
+				matched = append(matched, it)
+			}
+		}
+		return matched, nil
+	}
+
+	switch l := list.(type) {
+	case *corev1.PodList:
+		items, err := byIndex(r.pods)
+		if err != nil {
+			return err
+		}
+		l.Items = make([]corev1.Pod, 0, len(items))
+		for _, it := range items {
+			l.Items = append(l.Items, *it.(*corev1.Pod).DeepCopy())
+		}
+	case *corev1.ServiceList:
+		items, err := byIndex(r.services)
+		if err != nil {
+			return err
+		}
+		l.Items = make([]corev1.Service, 0, len(items))
+		for _, it := range items {
+			l.Items = append(l.Items, *it.(*corev1.Service).DeepCopy())
+		}
+	case *discoveryv1.EndpointSliceList:
+		// The synthetic fleet has no endpoints.
+		l.Items = nil
+	case *metav1.PartialObjectMetadataList:
+		// The synthetic fleet has no Helm release Secrets.
+		l.Items = nil
+	default:
+		return fmt.Errorf("indexerReader: unsupported list %T", list)
+	}
+	return nil
+}
+
+// syntheticFleet builds clusters vClusters in one host namespace, each with a control-plane pod,
+// podsPerCluster synced pods and a DNS mapping Service.
+func syntheticFleet(clusters, podsPerCluster int) ([]fleetv1alpha1.DiscoveredCluster, []corev1.Pod, []corev1.Service) {
+	discovered := make([]fleetv1alpha1.DiscoveredCluster, 0, clusters)
+	pods := make([]corev1.Pod, 0, clusters*(podsPerCluster+1))
+	services := make([]corev1.Service, 0, clusters*2)
+	ready := corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+
+	for c := range clusters {
+		name := fmt.Sprintf("vc-%04d", c)
+		discovered = append(discovered, fleetv1alpha1.DiscoveredCluster{Name: name, Namespace: "vcluster", ServiceName: name, ServicePort: 443})
+		services = append(services,
+			corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}}},
+			corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns-x-kube-system-x-" + name, Namespace: "vcluster"}},
+		)
+		pods = append(pods, corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster", "release": name}},
+			Status:     ready,
+		})
+		for p := range podsPerCluster {
+			origNS := "default"
+			if p == 0 {
+				origNS = "kube-system"
+			}
+			pods = append(pods, corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("app-%d-x-%s-x-%s", p, origNS, name),
+					Namespace: "vcluster",
+					Labels: map[string]string{
+						"vcluster.loft.sh/managed-by": name,
+						"vcluster.loft.sh/namespace":  origNS,
+					},
+				},
+				Status: ready,
+			})
+		}
+	}
+	return discovered, pods, services
+}
+
+var benchFleets = []struct{ clusters, podsPerCluster int }{
+	{10, 100},
+	{100, 100},
+	{200, 200},
+}
+
+// BenchmarkSnapshotLinearScan mirrors the previous reconcile: every detector scans the full
+// cluster-wide Pod and Service slices for each vCluster.
+func BenchmarkSnapshotLinearScan(b *testing.B) {
+	for _, f := range benchFleets {
+		discovered, pods, services := syntheticFleet(f.clusters, f.podsPerCluster)
+		b.Run(fmt.Sprintf("clusters=%d/pods=%d", f.clusters, len(pods)), func(b *testing.B) {
+			for b.Loop() {
+				for _, c := range discovered {
+					_ = slices.ContainsFunc(pods, func(p corev1.Pod) bool {
+						return p.Namespace == c.Namespace && p.Name == c.Name+"-0" && podReady(&p)
+					})
+					_ = findDNSService(c.Name, c.Namespace, services)
+					_ = countNodeServices(c.Name, c.Namespace, services)
+					_ = workloadSummary(c.Name, c.Namespace, pods)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSnapshotIndexed runs the built-in detectors through HostSnapshot over indexed stores.
+func BenchmarkSnapshotIndexed(b *testing.B) {
+	ctx := context.Background()
+	detectors := DefaultDetectorRegistry()
+	for _, f := range benchFleets {
+		discovered, pods, services := syntheticFleet(f.clusters, f.podsPerCluster)
+		reader := newIndexerReader(pods, services)
+		b.Run(fmt.Sprintf("clusters=%d/pods=%d", f.clusters, len(pods)), func(b *testing.B) {
+			for b.Loop() {
+				snap := NewHostSnapshot(reader)
+				for _, c := range discovered {
+					_ = detectors.Evaluate(ctx, c, snap)
+				}
+			}
+		})
+	}
+}
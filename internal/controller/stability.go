@@ -0,0 +1,222 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalControlPlaneStable reports crash-looping, OOM-killed or frequently restarting control-plane containers.
+const SignalControlPlaneStable = "controlPlaneStable"
+
+// Container issue reasons, most severe first.
+const (
+	IssueCrashLoopBackOff = "CrashLoopBackOff"
+	IssueOOMKilled        = "OOMKilled"
+	IssueFrequentRestarts = "FrequentRestarts"
+)
+
+const (
+	// DefaultRestartWindow is the window restarts are counted in when spec.controlPlane.restartWindowSeconds is unset.
+	DefaultRestartWindow = time.Hour
+	// DefaultMaxRestarts is how many restarts per window are tolerated when spec.controlPlane.maxRestarts is unset.
+	DefaultMaxRestarts = 2
+)
+
+// stabilityPolicy decides when control-plane containers count as unstable.
+type stabilityPolicy struct {
+	window      time.Duration
+	maxRestarts int32
+	// history remembers restart counts across reconciles; nil limits detection to the last termination.
+	history *restartHistory
+}
+
+// defaultStabilityPolicy has no history, so only the current and last container state are used.
+var defaultStabilityPolicy = stabilityPolicy{window: DefaultRestartWindow, maxRestarts: DefaultMaxRestarts}
+
+// stabilityPolicyFor returns the policy from spec.controlPlane, recording into history.
+func stabilityPolicyFor(spec fleetv1alpha1.VClusterHealthSpec, history *restartHistory) stabilityPolicy {
+	p := defaultStabilityPolicy
+	p.history = history
+	if cp := spec.ControlPlane; cp != nil {
+		if cp.RestartWindowSeconds != nil && *cp.RestartWindowSeconds > 0 {
+			p.window = time.Duration(*cp.RestartWindowSeconds) * time.Second
+		}
+		if cp.MaxRestarts != nil {
+			p.maxRestarts = *cp.MaxRestarts
+		}
+	}
+	return p
+}
+
+// inspect returns the unstable containers among pods and the total restarts within the window.
+func (p stabilityPolicy) inspect(pods []corev1.Pod, now time.Time) ([]fleetv1alpha1.ContainerIssue, int32) {
+	var issues []fleetv1alpha1.ContainerIssue
+	var total int32
+	for i := range pods {
+		pod := &pods[i]
+		for _, cs := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			recent := p.recentRestarts(pod, cs, now)
+			total += recent
+
+			reason := ""
+			switch {
+			case cs.State.Waiting != nil && cs.State.Waiting.Reason == IssueCrashLoopBackOff:
+				reason = IssueCrashLoopBackOff
+			case terminatedWithin(cs.LastTerminationState.Terminated, now, p.window, IssueOOMKilled),
+				terminatedWithin(cs.State.Terminated, now, p.window, IssueOOMKilled):
+				reason = IssueOOMKilled
+			case recent > p.maxRestarts:
+				reason = IssueFrequentRestarts
+			}
+			if reason != "" {
+				issues = append(issues, fleetv1alpha1.ContainerIssue{Pod: pod.Name, Container: cs.Name, Reason: reason, RecentRestarts: recent})
+			}
+		}
+	}
+	return issues, total
+}
+
+// recentRestarts estimates the restarts of one container within the window: the growth of its
+// restart count since the window began, as far as history reaches back, and at least 1 if its
+// last termination falls inside the window.
+func (p stabilityPolicy) recentRestarts(pod *corev1.Pod, cs corev1.ContainerStatus, now time.Time) int32 {
+	var recent int32
+	if p.history != nil {
+		recent = p.history.observe(string(pod.UID)+"/"+pod.Name+"/"+cs.Name, cs.RestartCount, now, p.window)
+	}
+	if recent == 0 && cs.RestartCount > 0 && terminatedWithin(cs.LastTerminationState.Terminated, now, p.window, "") {
+		recent = 1
+	}
+	return recent
+}
+
+// terminatedWithin reports whether t finished within window before now, with the given reason if not empty.
+func terminatedWithin(t *corev1.ContainerStateTerminated, now time.Time, window time.Duration, reason string) bool {
+	if t == nil || (reason != "" && t.Reason != reason) {
+		return false
+	}
+	return !t.FinishedAt.IsZero() && now.Sub(t.FinishedAt.Time) <= window
+}
+
+// restartHistory remembers container restart counts across reconciles, so restarts can be
+// counted within a sliding window although the API only reports a cumulative count. It is
+// kept in memory; after a controller restart, history builds up again. The zero value is ready to use.
+type restartHistory struct {
+	mu      sync.Mutex
+	entries map[string]*restartEntry
+}
+
+// restartEntry holds the restart count samples of one container, oldest first. A sample is
+// only added when the count changes.
+type restartEntry struct {
+	samples  []restartSample
+	window   time.Duration
+	lastSeen time.Time
+}
+
+type restartSample struct {
+	at    time.Time
+	count int32
+}
+
+// observe records count for key at now and returns how much it grew within window.
+func (h *restartHistory) observe(key string, count int32, now time.Time, window time.Duration) int32 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.entries == nil {
+		h.entries = map[string]*restartEntry{}
+	}
+	// Drop containers not seen for a whole window; their pods are gone.
+	for k, e := range h.entries {
+		if k != key && now.Sub(e.lastSeen) > e.window {
+			delete(h.entries, k)
+		}
+	}
+
+	e, ok := h.entries[key]
+	if !ok {
+		e = &restartEntry{}
+		h.entries[key] = e
+	}
+	e.window, e.lastSeen = window, now
+	if n := len(e.samples); n == 0 || e.samples[n-1].count != count {
+		e.samples = append(e.samples, restartSample{at: now, count: count})
+	}
+
+	// The baseline is the newest sample taken before the window began, or the oldest one if
+	// history does not reach back that far. Older samples are no longer needed.
+	base := 0
+	for i, s := range e.samples {
+		if now.Sub(s.at) < window {
+			break
+		}
+		base = i
+	}
+	e.samples = e.samples[base:]
+	return count - e.samples[0].count
+}
+
+// controlPlaneStableDetector reports whether the control-plane containers are stable (see stabilityPolicy).
+type controlPlaneStableDetector struct{}
+
+func (controlPlaneStableDetector) Name() string { return SignalControlPlaneStable }
+
+func (controlPlaneStableDetector) Evaluate(ctx context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	cp, err := snap.ControlPlane(ctx, c)
+	if err != nil {
+		return lookupFailed(err)
+	}
+	window := snap.stability.window
+	if len(cp.Pods) == 0 {
+		return DetectorResult{
+			Status:   metav1.ConditionUnknown,
+			Reason:   "PodNotFound",
+			Evidence: fmt.Sprintf("no control-plane pods for %s in namespace %s", c.Name, c.Namespace),
+		}
+	}
+	if len(cp.Issues) == 0 {
+		return DetectorResult{
+			Status:   metav1.ConditionTrue,
+			Reason:   "Stable",
+			Evidence: fmt.Sprintf("%d restarts in the last %s", cp.RecentRestarts, window),
+		}
+	}
+	reason := IssueFrequentRestarts
+	details := make([]string, 0, len(cp.Issues))
+	for _, is := range cp.Issues {
+		if is.Reason == IssueCrashLoopBackOff || (is.Reason == IssueOOMKilled && reason == IssueFrequentRestarts) {
+			reason = is.Reason
+		}
+		details = append(details, fmt.Sprintf("%s/%s: %s (%d restarts in %s)", is.Pod, is.Container, is.Reason, is.RecentRestarts, window))
+	}
+	return DetectorResult{
+		Status:   metav1.ConditionFalse,
+		Reason:   reason,
+		Evidence: strings.Join(details, "; "),
+	}
+}
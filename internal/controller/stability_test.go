@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("control-plane stability", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+	now := time.Now()
+
+	cpPod := func(statuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", UID: "uid-1", Labels: map[string]string{"app": "vcluster", "release": "vc-prod"}},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				ContainerStatuses: statuses,
+			},
+		}
+	}
+	terminated := func(reason string, ago time.Duration) corev1.ContainerState {
+		return corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: reason, FinishedAt: metav1.NewTime(now.Add(-ago))}}
+	}
+
+	It("is stable with no recent restarts", func() {
+		pod := cpPod(corev1.ContainerStatus{Name: "syncer", RestartCount: 4, LastTerminationState: terminated("Error", 3*time.Hour)})
+		res := controlPlaneStableDetector{}.Evaluate(ctx, cluster, newTestSnapshot(pod))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("Stable"))
+	})
+
+	It("flags CrashLoopBackOff even while the pod reads Ready", func() {
+		pod := cpPod(corev1.ContainerStatus{
+			Name:                 "syncer",
+			RestartCount:         7,
+			State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: IssueCrashLoopBackOff}},
+			LastTerminationState: terminated("Error", time.Minute),
+		})
+		snap := newTestSnapshot(pod)
+		res := controlPlaneStableDetector{}.Evaluate(ctx, cluster, snap)
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal(IssueCrashLoopBackOff))
+		Expect(res.Evidence).To(HavePrefix("vc-prod-0/syncer: CrashLoopBackOff"))
+
+		cp, err := snap.ControlPlane(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Status().Issues).To(ConsistOf(fleetv1alpha1.ContainerIssue{Pod: "vc-prod-0", Container: "syncer", Reason: IssueCrashLoopBackOff, RecentRestarts: 1}))
+	})
+
+	It("flags a recent OOMKilled termination", func() {
+		pod := cpPod(corev1.ContainerStatus{Name: "etcd", RestartCount: 1, LastTerminationState: terminated(IssueOOMKilled, 10*time.Minute)})
+		res := controlPlaneStableDetector{}.Evaluate(ctx, cluster, newTestSnapshot(pod))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal(IssueOOMKilled))
+
+		old := cpPod(corev1.ContainerStatus{Name: "etcd", RestartCount: 1, LastTerminationState: terminated(IssueOOMKilled, 2*time.Hour)})
+		Expect(controlPlaneStableDetector{}.Evaluate(ctx, cluster, newTestSnapshot(old)).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("counts restarts within the sliding window across reconciles", func() {
+		var history restartHistory
+		window := time.Hour
+		Expect(history.observe("c", 10, now, window)).To(BeZero(), "the first sample is the baseline")
+		Expect(history.observe("c", 12, now.Add(5*time.Minute), window)).To(Equal(int32(2)))
+		Expect(history.observe("c", 15, now.Add(30*time.Minute), window)).To(Equal(int32(5)))
+		// An hour after the 12 sample, only restarts since then count.
+		Expect(history.observe("c", 15, now.Add(66*time.Minute), window)).To(Equal(int32(3)))
+		Expect(history.observe("c", 15, now.Add(3*time.Hour), window)).To(BeZero())
+
+		policy := stabilityPolicy{window: window, maxRestarts: 2, history: &history}
+		status := corev1.ContainerStatus{Name: "syncer", RestartCount: 0}
+		pod := cpPod(status)
+		issues, _ := policy.inspect([]corev1.Pod{*pod}, now)
+		Expect(issues).To(BeEmpty())
+
+		pod.Status.ContainerStatuses[0].RestartCount = 3
+		pod.Status.ContainerStatuses[0].LastTerminationState = terminated("Error", time.Minute)
+		issues, total := policy.inspect([]corev1.Pod{*pod}, now.Add(15*time.Minute))
+		Expect(total).To(Equal(int32(3)))
+		Expect(issues).To(ConsistOf(HaveField("Reason", IssueFrequentRestarts)))
+	})
+
+	It("reads the window and threshold from spec.controlPlane", func() {
+		p := stabilityPolicyFor(fleetv1alpha1.VClusterHealthSpec{}, nil)
+		Expect(p.window).To(Equal(DefaultRestartWindow))
+		Expect(p.maxRestarts).To(Equal(int32(DefaultMaxRestarts)))
+
+		window, maxRestarts := int32(600), int32(0)
+		p = stabilityPolicyFor(fleetv1alpha1.VClusterHealthSpec{ControlPlane: &fleetv1alpha1.ControlPlaneSpec{
+			RestartWindowSeconds: &window,
+			MaxRestarts:          &maxRestarts,
+		}}, nil)
+		Expect(p.window).To(Equal(10 * time.Minute))
+		Expect(p.maxRestarts).To(BeZero())
+	})
+})
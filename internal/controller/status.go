@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// StatusFieldManager is the server-side apply field manager that owns VClusterHealth status.
+const StatusFieldManager = "vcluster-health-mirror-status"
+
+// DefaultStatusHeartbeat is how often status is rewritten when nothing has changed,
+// so lastUpdated keeps showing the controller is alive.
+const DefaultStatusHeartbeat = 10 * time.Minute
+
+// statusChanged reports whether next differs from prev once timestamps are ignored
+// (lastUpdated, lastChecked and condition transition times), as well as probe latency.
+func statusChanged(prev, next *fleetv1alpha1.VClusterHealthStatus) bool {
+	return !equality.Semantic.DeepEqual(withoutTimestamps(prev), withoutTimestamps(next))
+}
+
+func withoutTimestamps(in *fleetv1alpha1.VClusterHealthStatus) *fleetv1alpha1.VClusterHealthStatus {
+	out := in.DeepCopy()
+	out.LastUpdated = metav1.Time{}
+	for i := range out.Conditions {
+		out.Conditions[i].LastTransitionTime = metav1.Time{}
+	}
+	for i := range out.SyncCoverage {
+		cov := &out.SyncCoverage[i]
+		cov.LastChecked = metav1.Time{}
+		if cov.Probe != nil {
+			cov.Probe.LatencyMilliseconds = 0
+		}
+		for j := range cov.Conditions {
+			cov.Conditions[j].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return out
+}
+
+// heartbeatDue reports whether a status last written at lastUpdated should be rewritten anyway.
+func heartbeatDue(lastUpdated metav1.Time, now time.Time, heartbeat time.Duration) bool {
+	return lastUpdated.IsZero() || now.Sub(lastUpdated.Time) >= heartbeat
+}
+
+// applyStatus writes vh.Status with server-side apply as StatusFieldManager.
+// Only the status stanza is sent, so no resourceVersion precondition applies and
+// fields dropped from the computed status are removed from the object.
+func (r *VClusterHealthReconciler) applyStatus(ctx context.Context, vh *fleetv1alpha1.VClusterHealth) error {
+	status, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&vh.Status)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: map[string]any{"status": status}}
+	u.SetGroupVersionKind(fleetv1alpha1.GroupVersion.WithKind("VClusterHealth"))
+	u.SetNamespace(vh.Namespace)
+	u.SetName(vh.Name)
+	return r.Status().Apply(ctx, client.ApplyConfigurationFromUnstructured(u),
+		client.FieldOwner(StatusFieldManager), client.ForceOwnership)
+}
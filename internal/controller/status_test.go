@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// fakeStatusApply fills in the stored resourceVersion before applying status: the fake client
+// rejects subresource applies without one, unlike the API server.
+func fakeStatusApply(ctx context.Context, cl client.Client, sub string, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, u); err != nil {
+		return err
+	}
+	cur := &unstructured.Unstructured{}
+	cur.SetGroupVersionKind(u.GroupVersionKind())
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(u), cur); err != nil {
+		return err
+	}
+	u.SetResourceVersion(cur.GetResourceVersion())
+	return cl.SubResource(sub).Apply(ctx, client.ApplyConfigurationFromUnstructured(u), opts...)
+}
+
+var _ = Describe("status writes", func() {
+	earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	baseStatus := func() *fleetv1alpha1.VClusterHealthStatus {
+		return &fleetv1alpha1.VClusterHealthStatus{
+			LastUpdated: earlier,
+			Conditions:  []metav1.Condition{{Type: fleetv1alpha1.ConditionReady, Status: metav1.ConditionTrue, LastTransitionTime: earlier}},
+			SyncCoverage: []fleetv1alpha1.SyncCoverage{{
+				ClusterName: "vc-prod",
+				Score:       100,
+				Level:       "Full",
+				LastChecked: earlier,
+				Signals:     []fleetv1alpha1.SignalResult{{Name: SignalAPISync, Status: metav1.ConditionTrue}},
+			}},
+		}
+	}
+
+	It("ignores timestamps when comparing status", func() {
+		prev := baseStatus()
+		next := baseStatus()
+		next.LastUpdated = metav1.Now()
+		next.SyncCoverage[0].LastChecked = metav1.Now()
+		next.Conditions[0].LastTransitionTime = metav1.Now()
+		prev.SyncCoverage[0].Probe = &fleetv1alpha1.ProbeStatus{StatusCode: 200, LatencyMilliseconds: 12}
+		next.SyncCoverage[0].Probe = &fleetv1alpha1.ProbeStatus{StatusCode: 200, LatencyMilliseconds: 40}
+		Expect(statusChanged(prev, next)).To(BeFalse())
+
+		next.SyncCoverage[0].Signals[0].Status = metav1.ConditionFalse
+		Expect(statusChanged(prev, next)).To(BeTrue())
+	})
+
+	It("is due for a heartbeat when never written or the period has elapsed", func() {
+		now := time.Now()
+		Expect(heartbeatDue(metav1.Time{}, now, time.Minute)).To(BeTrue())
+		Expect(heartbeatDue(metav1.NewTime(now.Add(-30*time.Second)), now, time.Minute)).To(BeFalse())
+		Expect(heartbeatDue(metav1.NewTime(now.Add(-time.Minute)), now, time.Minute)).To(BeTrue())
+	})
+
+	It("skips unchanged writes and applies status with its own field manager", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1", Ports: []corev1.ServicePort{{Port: 443}}},
+		}
+		applies := 0
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(vh, svc).
+			WithStatusSubresource(vh).
+			WithIndex(&corev1.Pod{}, indexPodVClusterOwner, podVClusterOwners).
+			WithIndex(&corev1.Pod{}, indexPodRelease, podRelease).
+			WithReturnManagedFields().
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceApply: func(ctx context.Context, cl client.Client, sub string, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+					applies++
+					return fakeStatusApply(ctx, cl, sub, obj, opts...)
+				},
+			}).
+			Build()
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}}
+
+		r := &VClusterHealthReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applies).To(Equal(1))
+
+		var got fleetv1alpha1.VClusterHealth
+		Expect(c.Get(context.Background(), req.NamespacedName, &got)).To(Succeed())
+		Expect(got.Status.SyncCoverage).To(HaveLen(1))
+		managers := []string{}
+		for _, mf := range got.ManagedFields {
+			managers = append(managers, mf.Manager)
+		}
+		Expect(managers).To(ContainElement(StatusFieldManager))
+
+		_, err = r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applies).To(Equal(1), "an unchanged status must not be rewritten")
+
+		r.StatusHeartbeat = time.Nanosecond
+		_, err = r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applies).To(Equal(2), "an elapsed heartbeat forces a write")
+	})
+})
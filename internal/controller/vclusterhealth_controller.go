@@ -18,24 +18,61 @@ package controller
 
 import (
 	"context"
-	"strings"
+	"sort"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+	"github.com/vrahul1997/vcluster-health-mirror/internal/translate"
 )
 
 // VClusterHealthReconciler reconciles a VClusterHealth object
 type VClusterHealthReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Detectors is the set of signal detectors evaluated for every vCluster.
+	// If nil, DefaultDetectorRegistry is used.
+	Detectors *DetectorRegistry
+
+	// WatchDebounce delays reconciles triggered by Pod/Service events so bursts collapse into one.
+	// If 0, DefaultWatchDebounce is used. Polling every IntervalSeconds remains as a safety-net resync.
+	WatchDebounce time.Duration
+
+	// StatusHeartbeat is how often an unchanged status is rewritten anyway.
+	// If 0, DefaultStatusHeartbeat is used.
+	StatusHeartbeat time.Duration
+
+	// Recorder emits an Event on the VClusterHealth object and the vCluster's API Service for
+	// every per-cluster signal or level transition. If nil, no events are emitted.
+	Recorder events.EventRecorder
+
+	// APIReader reads Secrets (kubeconfig, config and Helm release). It should be the manager's
+	// uncached reader, so Secrets are not cached cluster-wide. If nil, Client is used.
+	APIReader client.Reader
+
+	// VolumeStats reports datastore volume usage for the backingStoreHealthy signal.
+	// If nil, only claim phases are checked.
+	VolumeStats VolumeStatsSource
+
+	// ReadVClusterConfig reads each vCluster's Helm release and vc-config Secret, for the
+	// releaseHealthy signal and per-cluster expectations. It needs get and list on Secrets.
+	ReadVClusterConfig bool
+
+	eventLimits transitionLimiter
+	restarts    restartHistory
 }
 
 // +kubebuilder:rbac:groups=fleet.health.io,resources=vclusterhealths,verbs=get;list;watch;create;update;patch;delete
@@ -43,6 +80,11 @@ type VClusterHealthReconciler struct {
 // +kubebuilder:rbac:groups=fleet.health.io,resources=vclusterhealths/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets;deployments;replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -61,8 +103,13 @@ func (r *VClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Error block for getting the object (default/fleet)
 	if err := r.Get(ctx, req.NamespacedName, &vh); err != nil {
+		if apierrors.IsNotFound(err) {
+			forgetFleetMetrics(req.NamespacedName.String())
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	// Status as last written, to skip writes that would change nothing but timestamps.
+	prevStatus := vh.Status.DeepCopy()
 
 	// Interval compute ==> Get the inyterval from CR and multply it by second
 	interval := time.Duration(vh.Spec.IntervalSeconds) * time.Second
@@ -73,60 +120,70 @@ func (r *VClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	logger.Info("loaded vCluster", "name", req.NamespacedName.String(), "next", interval.String())
 
-	// Namespace selection:
-	// - default: "vcluster"
-	// - "*" or "all": discover vClusters across all namespaces
-	targetNS := vh.Spec.Namespace
-	if targetNS == "" {
-		targetNS = "vcluster"
+	// Namespace selection (see newNamespaceMatcher) and Service selectors (see discoverySelectors).
+	// Invalid selections are spec errors: they are reported as Stalled and not retried until the spec changes.
+	nsMatcher, err := newNamespaceMatcher(vh.Spec)
+	if err != nil {
+		logger.Error(err, "invalid namespace selection")
+		_, _ = r.markStalled(ctx, &vh, prevStatus, ReasonInvalidNamespaceSelection, err)
+		return ctrl.Result{}, nil
+	}
+	include, exclude, err := discoverySelectors(vh.Spec)
+	if err != nil {
+		logger.Error(err, "invalid discovery selector")
+		_, _ = r.markStalled(ctx, &vh, prevStatus, ReasonInvalidDiscoverySelector, err)
+		return ctrl.Result{}, nil
+	}
+	cpSelector, err := controlPlaneSelectorFor(vh.Spec)
+	if err != nil {
+		logger.Error(err, "invalid control-plane selector")
+		_, _ = r.markStalled(ctx, &vh, prevStatus, ReasonInvalidControlPlaneSelector, err)
+		return ctrl.Result{}, nil
+	}
+	versionPolicy, err := versionPolicyFor(vh.Spec)
+	if err != nil {
+		logger.Error(err, "invalid version policy")
+		_, _ = r.markStalled(ctx, &vh, prevStatus, ReasonInvalidVersionPolicy, err)
+		return ctrl.Result{}, nil
 	}
-	allNamespaces := targetNS == "*" || targetNS == "all"
 
 	var svcList corev1.ServiceList
 
-	// List vCluster API Services (app=vcluster). If allNamespaces is enabled, list cluster-wide.
+	// List vCluster API Services (app=vcluster by default). A single target namespace is listed
+	// directly; any other selection is listed cluster-wide and filtered by nsMatcher below.
 	svcListOpts := &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(map[string]string{"app": "vcluster"}),
-	}
-	if !allNamespaces {
-		svcListOpts.Namespace = targetNS
+		LabelSelector: include,
+		Namespace:     nsMatcher.single,
 	}
 	if err := r.List(ctx, &svcList, svcListOpts); err != nil {
-		logger.Error(err, "failed to list vcluster services", "namespace", targetNS, "allNamespaces", allNamespaces)
-		return ctrl.Result{RequeueAfter: interval}, nil
-	}
-	// log the discovered services in the current namespace, future change it to all the available namespaces.
-	logger.Info("discovered services", "namespace", targetNS, "allNamespaces", allNamespaces, "count", len(svcList.Items))
-
-	// List all Services cluster-wide. We'll filter by namespace when evaluating DNS/Node signals.
-	var allSvcList corev1.ServiceList
-	if err := r.List(ctx, &allSvcList, &client.ListOptions{}); err != nil {
-		logger.Error(err, "failed to list all services")
-		return ctrl.Result{RequeueAfter: interval}, nil
-	}
-
-	// List pods cluster-wide. We'll filter by namespace for control-plane readiness.
-	var podList corev1.PodList
-	if err := r.List(ctx, &podList, &client.ListOptions{}); err != nil {
-		logger.Error(err, "failed to list pods")
-		return ctrl.Result{RequeueAfter: interval}, nil
+		logger.Error(err, "failed to list vcluster services", "namespace", nsMatcher.single)
+		return r.markStalled(ctx, &vh, prevStatus, ReasonListServicesFailed, err)
 	}
-
-	// List pods across all namespaces to detect synced workloads.
-	// Workloads synced from the vCluster usually live outside the vcluster control-plane namespace.
-	var allPodList corev1.PodList
-	if err := r.List(ctx, &allPodList, &client.ListOptions{}); err != nil {
-		logger.Error(err, "failed to list all pods")
-		return ctrl.Result{RequeueAfter: interval}, nil
+	var nsLabels map[string]map[string]string
+	if nsMatcher.needsLabels() {
+		if nsLabels, err = namespaceLabels(ctx, r.Client); err != nil {
+			logger.Error(err, "failed to list namespaces")
+			return r.markStalled(ctx, &vh, prevStatus, ReasonListNamespacesFailed, err)
+		}
 	}
+	// log the discovered services in the current namespace, future change it to all the available namespaces.
+	logger.Info("discovered services", "namespace", nsMatcher.single, "count", len(svcList.Items))
 
 	discovered := make([]fleetv1alpha1.DiscoveredCluster, 0, len(svcList.Items))
+	// API Services by namespace/name, used for rule metadata (labels, age).
+	apiServices := make(map[string]*corev1.Service, len(svcList.Items))
 
 	for _, s := range svcList.Items {
 		// We have some headless helper services that doesnt have a cluster ip, we will omit them with this block
 		if s.Spec.ClusterIP == corev1.ClusterIPNone {
 			continue
 		}
+		if exclude != nil && exclude.Matches(labels.Set(s.Labels)) {
+			continue
+		}
+		if !nsMatcher.matches(s.Namespace, nsLabels[s.Namespace]) {
+			continue
+		}
 		// Pick port 443 if present, otherwise fall back to the first port.
 		var port int32 = 443
 		if len(s.Spec.Ports) > 0 {
@@ -139,6 +196,7 @@ func (r *VClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			}
 		}
 
+		apiServices[s.Namespace+"/"+s.Name] = &s
 		discovered = append(discovered, fleetv1alpha1.DiscoveredCluster{
 			Name:        s.Name,
 			Namespace:   s.Namespace,
@@ -150,45 +208,194 @@ func (r *VClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	logger.Info("built discovered cluster", "count", len(discovered))
 
-	// ---- Sync Coverage (API sync only, initial step) ----
+	// ---- Sync Coverage (one signal per registered detector) ----
 	syncCoverage := make([]fleetv1alpha1.SyncCoverage, 0, len(discovered))
 	now := v1.Now()
 
-	for _, c := range discovered {
-		// we able to discover the cluster, only because API server was working, so defaults to true
-		api := true
-		cp := isControlPlaneReady(c.Name, c.Namespace, podList.Items)
-		dns := hasDNSSync(c.Name, c.Namespace, allSvcList.Items)
-		node := hasNodeSync(c.Name, c.Namespace, allSvcList.Items)
-
-		// For workload detection, the namespace to treat as "control plane" is the vCluster's namespace.
-		sysWL, tenantWL := workloadSyncSplit(c.Name, c.Namespace, allPodList.Items)
-		wl := sysWL || tenantWL // legacy aggregate
-
-		score, level := computeScoreLevel(api, cp, dns, node, sysWL, tenantWL)
-
-		// If we discovered the API Service for a vCluster, API sync is considered present.
-		syncCoverage = append(syncCoverage, fleetv1alpha1.SyncCoverage{
-			ClusterName:        c.Name,
-			ApiSync:            api,
-			ControlPlaneReady:  cp,
-			DnsSync:            dns,
-			NodeSync:           node,
-			WorkloadSync:       wl,
-			SystemWorkloadSync: sysWL,
-			TenantWorkloadSync: tenantWL,
-			Score:              score,
-			Level:              level,
-			LastChecked:        now,
-		})
+	detectors := r.Detectors
+	if detectors == nil {
+		detectors = DefaultDetectorRegistry()
+	}
+	// Detectors query the cache indexes for one vCluster at a time (see SetupIndexes).
+	snap := NewHostSnapshot(r.Client)
+	snap.controlPlaneSelector = cpSelector
+	snap.stability = stabilityPolicyFor(vh.Spec, &r.restarts)
+	snap.volumeStats = r.VolumeStats
+	snap.capacityThreshold = capacityThresholdFor(vh.Spec)
+	snap.minHealthyPercent = minHealthyPercentFor(vh.Spec)
+	// Secrets are read uncached, so the manager never watches them.
+	apiReader := r.APIReader
+	if apiReader == nil {
+		apiReader = r.Client
+	}
+	snap.apiReader = apiReader
+	snap.readConfig = r.ReadVClusterConfig
+	if vh.Spec.Probe != nil {
+		snap.prober = newAPIProber(apiReader, vh.Spec.Probe)
+		detectors = detectors.with(apiProbeDetector{})
+	}
+	if certs := certificatePolicyFor(vh.Spec); certs != nil {
+		snap.certificates = certs
+		detectors = detectors.with(certificatesDetector{})
+	}
+	if versionPolicy != nil {
+		snap.versionPolicy = versionPolicy
+		detectors = detectors.with(upToDateDetector{})
+	}
+
+	meta.SetStatusCondition(&vh.Status.Conditions, expectationsCondition(r.ReadVClusterConfig, vh.Generation))
+
+	// Compile custom rules once per reconcile; compile errors are surfaced as a condition, not a failure.
+	rules, ruleErrs := compileRules(vh.Spec.Rules)
+	if len(vh.Spec.Rules) > 0 {
+		meta.SetStatusCondition(&vh.Status.Conditions, rulesCondition(ruleErrs, vh.Generation))
+	} else {
+		meta.RemoveStatusCondition(&vh.Status.Conditions, fleetv1alpha1.ConditionRulesValid)
+	}
+	if len(ruleErrs) > 0 {
+		logger.Info("some rules failed to compile", "errors", ruleErrs)
+	}
+
+	// Previous coverage by namespace/name, so per-signal condition transition times survive
+	// reconciles. Entries written before the namespace was recorded are keyed by name alone.
+	prevCoverage := make(map[string]*fleetv1alpha1.SyncCoverage, len(vh.Status.SyncCoverage))
+	for i := range vh.Status.SyncCoverage {
+		cov := &vh.Status.SyncCoverage[i]
+		key := cov.ClusterName
+		if cov.Namespace != "" {
+			key = cov.Namespace + "/" + key
+		}
+		prevCoverage[key] = cov
+	}
+
+	fleetMetrics := newFleetMetrics(req.NamespacedName.String(), vh.Spec.Scoring)
+	// Transitions are only emitted once the host state was read without errors.
+	type pendingEvents struct {
+		cluster     fleetv1alpha1.DiscoveredCluster
+		transitions []healthTransition
+	}
+	var pending []pendingEvents
+
+	// Probes dial each vCluster and may wait for the timeout, so they run up front in parallel.
+	snap.probeAll(ctx, discovered)
+	for i := range discovered {
+		snap.describeCluster(ctx, &discovered[i])
+		c := discovered[i]
+		signals := detectors.Evaluate(ctx, c, snap)
+
+		sysWL := signalTrue(signals, SignalSystemWorkloadSync)
+		tenantWL := signalTrue(signals, SignalTenantWorkloadSync)
+		// Only signals the vCluster's own config enables are scored.
+		expectations, err := snap.Expectations(ctx, c)
+		if err != nil {
+			logger.Error(err, "failed to read vCluster config, expecting every signal", "cluster", c.Name)
+		}
+		score, level := computeScoreLevel(expectedSignals(signals, expectations), vh.Spec.Scoring)
+
+		cov := fleetv1alpha1.SyncCoverage{
+			ClusterName:         c.Name,
+			Namespace:           c.Namespace,
+			ApiSync:             signalTrue(signals, SignalAPISync),
+			ControlPlaneReady:   signalTrue(signals, SignalControlPlaneReady),
+			ControlPlaneStable:  signalTrue(signals, SignalControlPlaneStable),
+			BackingStoreHealthy: signalTrue(signals, SignalBackingStoreHealthy),
+			ReleaseHealthy:      signalTrue(signals, SignalReleaseHealthy) || signalDisabled(signals, SignalReleaseHealthy),
+			DnsSync:             signalTrue(signals, SignalDNSSync),
+			NodeSync:            signalTrue(signals, SignalNodeSync),
+			WorkloadSync:        sysWL || tenantWL, // legacy aggregate
+			SystemWorkloadSync:  sysWL,
+			TenantWorkloadSync:  tenantWL,
+			Signals:             signals,
+			Expectations:        compareExpectations(signals, expectations),
+			Score:               score,
+			Level:               level,
+			LastChecked:         now,
+		}
+		if expectations != nil {
+			cov.ConfigSource = expectations.Source
+		}
+		if cp, err := snap.ControlPlane(ctx, c); err == nil {
+			cov.ControlPlane = cp.Status()
+		}
+		if eps, err := snap.APIEndpoints(ctx, c); err == nil {
+			cov.ApiEndpoints = eps.Status()
+		}
+		if bs, err := snap.BackingStore(ctx, c); err == nil {
+			cov.BackingStore = bs.Status()
+		}
+		if wl, err := snap.Workloads(ctx, c); err == nil {
+			cov.Workloads = wl.Status()
+		}
+		if res, err := snap.Probe(ctx, c); err == nil && res != nil {
+			cov.Probe = res.Status()
+		}
+		if st, err := snap.Certificates(ctx, c); err == nil && st != nil {
+			cov.Certificates = st.Status()
+		}
+
+		var prevConds []v1.Condition
+		prev, ok := prevCoverage[c.Namespace+"/"+c.Name]
+		if !ok {
+			prev = prevCoverage[c.Name]
+		}
+		if prev != nil {
+			prevConds = prev.Conditions
+		}
+		cov.Conditions = signalConditions(prevConds, signals, vh.Generation)
+
+		in := ruleInput{Coverage: cov, Cluster: c}
+		if svc := apiServices[c.Namespace+"/"+c.ServiceName]; svc != nil {
+			in.Labels = svc.Labels
+			in.Age = now.Sub(svc.CreationTimestamp.Time)
+		}
+		var override string
+		cov.Rules, override = evaluateRules(rules, in)
+		if override != "" {
+			cov.Level = override
+		}
+
+		syncCoverage = append(syncCoverage, cov)
+		fleetMetrics.observe(c, cov)
+		if ts := healthTransitions(prev, cov); len(ts) > 0 {
+			pending = append(pending, pendingEvents{cluster: c, transitions: ts})
+		}
+	}
+
+	if reason, err := snap.Err(); err != nil {
+		logger.Error(err, "failed to read host state for detectors")
+		return r.markStalled(ctx, &vh, prevStatus, reason, err)
+	}
+	var outOfPolicy *int32
+	if versionPolicy != nil {
+		n := countOutOfPolicy(syncCoverage)
+		outOfPolicy = &n
+		fleetMetrics.outOfPolicy(n)
+	}
+	// Metrics are exported even when the status write below is skipped.
+	fleetMetrics.commit(len(discovered))
+	for _, p := range pending {
+		r.emitTransitions(ctx, &vh, apiServices[p.cluster.Namespace+"/"+p.cluster.ServiceName], p.cluster, p.transitions)
 	}
 
 	vh.Status.Clusters = discovered
 	vh.Status.SyncCoverage = syncCoverage
-	if err := r.Status().Update(ctx, &vh); err != nil {
-		logger.Error(err, "failed to update VclusterHealth status")
+	vh.Status.OutOfPolicyClusters = outOfPolicy
+	vh.Status.LastUpdated = now
+	vh.Status.ObservedGeneration = vh.Generation
+	setHealthConditions(&vh, syncCoverage)
+
+	heartbeat := r.StatusHeartbeat
+	if heartbeat <= 0 {
+		heartbeat = DefaultStatusHeartbeat
+	}
+	if !statusChanged(prevStatus, &vh.Status) && !heartbeatDue(prevStatus.LastUpdated, now.Time, heartbeat) {
+		logger.V(1).Info("status unchanged, skipping write")
 		return ctrl.Result{RequeueAfter: interval}, nil
 	}
+	if err := r.applyStatus(ctx, &vh); err != nil {
+		logger.Error(err, "failed to apply VclusterHealth status")
+		return ctrl.Result{}, err
+	}
 
 	logger.Info("updated status.clusters", "count", len(vh.Status.Clusters))
 	logger.Info("updated status.syncCoverage", "count", len(vh.Status.SyncCoverage))
@@ -196,201 +403,134 @@ func (r *VClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{RequeueAfter: interval}, nil
 }
 
-// isControlPlaneReady returns true if the vCluster control-plane pod (<name>-0) in the given namespace is Running and Ready.
-func isControlPlaneReady(vclusterName, namespace string, pods []corev1.Pod) bool {
-	target := vclusterName + "-0"
-	for _, p := range pods {
-		if p.Namespace != namespace {
-			continue
-		}
-		if p.Name != target {
+// markStalled records that the host cluster could not be observed and returns err,
+// so the request is retried with backoff instead of waiting for the next interval.
+// The status is only written if the conditions changed from prev.
+func (r *VClusterHealthReconciler) markStalled(ctx context.Context, vh *fleetv1alpha1.VClusterHealth, prev *fleetv1alpha1.VClusterHealthStatus, reason string, err error) (ctrl.Result, error) {
+	setStalledConditions(vh, reason, err)
+	vh.Status.ObservedGeneration = vh.Generation
+	if !statusChanged(prev, &vh.Status) {
+		return ctrl.Result{}, err
+	}
+	if uerr := r.applyStatus(ctx, vh); uerr != nil {
+		log.FromContext(ctx).Error(uerr, "failed to apply VclusterHealth status")
+	}
+	return ctrl.Result{}, err
+}
+
+// emitTransitions records each transition as an Event on vh and, if known, on the vCluster's
+// API Service. Events beyond the per-cluster rate limit are dropped.
+func (r *VClusterHealthReconciler) emitTransitions(ctx context.Context, vh *fleetv1alpha1.VClusterHealth, svc *corev1.Service, c fleetv1alpha1.DiscoveredCluster, transitions []healthTransition) {
+	if r.Recorder == nil {
+		return
+	}
+	var related runtime.Object
+	if svc != nil {
+		related = svc
+	}
+	key := vh.Namespace + "/" + vh.Name + "/" + c.Namespace + "/" + c.Name
+	for _, t := range transitions {
+		if !r.eventLimits.allow(key, time.Now()) {
+			log.FromContext(ctx).V(1).Info("dropping rate-limited event", "cluster", c.Name, "reason", t.Reason)
 			continue
 		}
-		if p.Status.Phase != corev1.PodRunning {
-			return false
-		}
-		for _, c := range p.Status.Conditions {
-			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
-				return true
-			}
+		r.Recorder.Eventf(vh, related, t.Type, t.Reason, EventActionHealthCheck, "%s", t.Note)
+		if svc != nil {
+			r.Recorder.Eventf(svc, vh, t.Type, t.Reason, EventActionHealthCheck, "%s", t.Note)
 		}
-		return false
 	}
-	return false
 }
 
-// hasDNSSync returns true if a kube-dns mapping Service exists for the vCluster in the given namespace.
-// Example: kube-dns-x-kube-system-x-vc-prod
-func hasDNSSync(vclusterName, namespace string, services []corev1.Service) bool {
-	for _, s := range services {
+// findDNSService returns the kube-dns mapping Service for the vCluster in the given namespace, or nil.
+func findDNSService(vclusterName, namespace string, services []corev1.Service) *corev1.Service {
+	want := dnsServiceName(vclusterName)
+	for i := range services {
+		s := &services[i]
 		if s.Namespace != namespace {
 			continue
 		}
-		if strings.Contains(s.Name, "kube-dns") && strings.Contains(s.Name, vclusterName) {
-			return true
+		if s.Name == want {
+			return s
 		}
 	}
-	return false
+	return nil
+}
+
+// dnsServiceName returns the host name of the vCluster's kube-dns Service.
+func dnsServiceName(vclusterName string) string {
+	return translate.HostName("kube-dns", "kube-system", vclusterName)
 }
 
-// hasNodeSync returns true if node-mapping Services exist for the vCluster in the given namespace.
-// Example: vc-prod-node-k3d-k3s-default-server-0
-func hasNodeSync(vclusterName, namespace string, services []corev1.Service) bool {
-	needle := vclusterName + "-node-"
+// countNodeServices counts the node-mapping Services for the vCluster in the given namespace.
+func countNodeServices(vclusterName, namespace string, services []corev1.Service) int {
+	n := 0
 	for _, s := range services {
 		if s.Namespace != namespace {
 			continue
 		}
-		if strings.Contains(s.Name, needle) {
-			return true
+		if translate.IsNodeService(s.Name, vclusterName) {
+			n++
 		}
 	}
-	return false
+	return n
 }
 
-//  1. Label-based: pods created by the syncer often carry a vCluster label whose value == vclusterName.
-//  2. Namespace-name fallback: some setups create namespaces that contain "-x-<vclusterName>".
+// computeScoreLevel converts detector results into a percentage score and a human-friendly level.
+// Only status True counts as present.
 //
-// IMPORTANT: Do NOT skip the entire control-plane namespace. In many setups (including yours),
-// synced workload pods live in the same namespace as the vCluster control plane (e.g. nginx-x-default-x-vc-prod in namespace vcluster).
-// Instead, we skip only true control-plane pods (app=vcluster) and the StatefulSet pod (<name>-0).
-func hasWorkloadSync(vclusterName, controlPlaneNamespace string, pods []corev1.Pod) bool {
-	// Common labels used by vCluster for synced objects (varies by version/config).
-	labelKeys := []string{
-		"vcluster.loft.sh/managed-by",
-		"vcluster.loft.sh/vcluster-name",
-		"vcluster.loft.sh/cluster",
-		"vcluster.loft.sh/owner",
-	}
-
-	nsNeedle := "-x-" + vclusterName
-	controlPlanePod := vclusterName + "-0"
-
-	for _, p := range pods {
-		// Skip control-plane namespace entirely; we only care about synced workloads.
-		if p.Namespace == controlPlaneNamespace && p.Name == controlPlanePod {
-			continue
-		}
-
-		// Skip other control-plane components (they usually carry app=vcluster).
-		if p.Labels != nil {
-			if app, ok := p.Labels["app"]; ok && app == "vcluster" {
-				continue
-			}
-		}
-
-		// 1) Label-based detection.
-		if p.Labels != nil {
-			for _, k := range labelKeys {
-				if v, ok := p.Labels[k]; ok && v == vclusterName {
-					return true
-				}
+// With a nil policy every signal carries equal weight and:
+// - Score: 0..100
+// - Level: None (no signals), Partial (some signals), Full (all signals)
+//
+// With a policy, each signal weighs policy.Signals[].Weight (default 1), the level is the
+// highest threshold the score reaches, and a missing required signal forces the lowest level.
+// Results of a disabled check never count.
+func computeScoreLevel(signals []fleetv1alpha1.SignalResult, policy *fleetv1alpha1.ScoringPolicy) (int32, string) {
+	weights := map[string]int32{}
+	required := map[string]bool{}
+	if policy != nil {
+		for _, sp := range policy.Signals {
+			if sp.Weight != nil {
+				weights[sp.Name] = *sp.Weight
 			}
-		}
-
-		// 2) Namespace-pattern fallback.
-		if strings.Contains(p.Namespace, nsNeedle) {
-			return true
+			required[sp.Name] = sp.Required
 		}
 	}
 
-	return false
-}
-
-// workloadSyncSplit returns (systemWorkload, tenantWorkload).
-// We use the vCluster-provided label `vcluster.loft.sh/namespace` to understand the ORIGINAL namespace inside the vCluster.
-// - systemWorkload: any synced pod whose original namespace == kube-system
-// - tenantWorkload: any synced pod whose original namespace != kube-system
-// Control-plane pods (app=vcluster) and the StatefulSet pod (<name>-0) are excluded.
-func workloadSyncSplit(vclusterName, controlPlaneNamespace string, pods []corev1.Pod) (bool, bool) {
-	labelKeys := []string{
-		"vcluster.loft.sh/managed-by",
-		"vcluster.loft.sh/vcluster-name",
-		"vcluster.loft.sh/cluster",
-		"vcluster.loft.sh/owner",
-	}
-
-	controlPlanePod := vclusterName + "-0"
-	system := false
-	tenant := false
-
-	for _, p := range pods {
-		// Exclude the control-plane StatefulSet pod.
-		if p.Namespace == controlPlaneNamespace && p.Name == controlPlanePod {
-			continue
-		}
-		// Exclude other control-plane components.
-		if p.Labels != nil {
-			if app, ok := p.Labels["app"]; ok && app == "vcluster" {
-				continue
-			}
-		}
-
-		// Determine whether this pod belongs to this vCluster (label-based detection).
-		belongs := false
-		if p.Labels != nil {
-			for _, k := range labelKeys {
-				if v, ok := p.Labels[k]; ok && v == vclusterName {
-					belongs = true
-					break
-				}
-			}
-		}
-		if !belongs {
+	total := int32(0)
+	points := int32(0)
+	missingRequired := false
+	for _, s := range signals {
+		if s.Reason == reasonCheckDisabled {
 			continue
 		}
-
-		origNS := ""
-		if p.Labels != nil {
-			origNS = p.Labels["vcluster.loft.sh/namespace"]
-		}
-
-		if origNS == "kube-system" {
-			system = true
-		} else {
-			// If we can't determine origNS, we conservatively treat it as tenant.
-			tenant = true
+		w, ok := weights[s.Name]
+		if !ok {
+			w = 1
 		}
-
-		if system && tenant {
-			return true, true
+		total += w
+		if s.Status == v1.ConditionTrue {
+			points += w
+		} else if required[s.Name] {
+			missingRequired = true
 		}
 	}
 
-	return system, tenant
-}
-
-// computeScoreLevel converts sync booleans into a simple percentage score and a human-friendly level.
-// total signals: API, ControlPlaneReady, DNS, Node, SystemWorkload, TenantWorkload (6).
-// - Score: 0..100
-// - Level: None (0/6), Partial (1-5/6), Full (6/6)
-func computeScoreLevel(api, controlPlane, dns, node, systemWorkload, tenantWorkload bool) (int32, string) {
-	total := int32(6)
-	points := int32(0)
-	if api {
-		points++
-	}
-	if controlPlane {
-		points++
-	}
-	if dns {
-		points++
-	}
-	if node {
-		points++
-	}
-	if systemWorkload {
-		points++
-	}
-	if tenantWorkload {
-		points++
+	// integer percentage
+	score := int32(0)
+	if total > 0 {
+		score = (points * 100) / total
 	}
 
-	// integer percentage
-	score := (points * 100) / total
+	if policy != nil && len(policy.Levels) > 0 {
+		return score, levelForScore(score, missingRequired, policy.Levels)
+	}
 
 	level := "None"
-	if points == total {
+	if missingRequired {
+		return score, level
+	}
+	if total > 0 && points == total {
 		level = "Full"
 	} else if points > 0 {
 		level = "Partial"
@@ -399,10 +539,52 @@ func computeScoreLevel(api, controlPlane, dns, node, systemWorkload, tenantWorkl
 	return score, level
 }
 
+// levelForScore returns the name of the highest threshold reached by score.
+// If forceLowest is set, or no threshold is reached, the lowest threshold wins.
+func levelForScore(score int32, forceLowest bool, levels []fleetv1alpha1.LevelThreshold) string {
+	sorted := make([]fleetv1alpha1.LevelThreshold, len(levels))
+	copy(sorted, levels)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].MinScore > sorted[j].MinScore })
+
+	lowest := sorted[len(sorted)-1].Name
+	if forceLowest {
+		return lowest
+	}
+	for _, l := range sorted {
+		if score >= l.MinScore {
+			return l.Name
+		}
+	}
+	return lowest
+}
+
 // SetupWithManager sets up the controller with the Manager.
+// Besides VClusterHealth objects it watches Pods and Services, so control-plane or sync changes
+// are noticed without waiting for the next poll, and Namespaces, so namespaces that start matching
+// spec.namespaceSelector or spec.namespacePatterns are picked up.
 func (r *VClusterHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	debounce := r.WatchDebounce
+	if debounce <= 0 {
+		debounce = DefaultWatchDebounce
+	}
+	if err := SetupIndexes(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&fleetv1alpha1.VClusterHealth{}).
+		Watches(&corev1.Pod{},
+			debouncedEnqueue(r.fleetsForObject, debounce),
+			builder.WithPredicates(podHealthChanged())).
+		Watches(&corev1.Service{},
+			debouncedEnqueue(r.fleetsForObject, debounce)).
+		Watches(&discoveryv1.EndpointSlice{},
+			debouncedEnqueue(r.fleetsForObject, debounce),
+			builder.WithPredicates(endpointReadinessChanged())).
+		Watches(&corev1.PersistentVolumeClaim{},
+			debouncedEnqueue(r.fleetsForObject, debounce)).
+		Watches(&corev1.Namespace{},
+			debouncedEnqueue(r.fleetsForNamespace, debounce),
+			builder.WithPredicates(namespaceLabelsChanged())).
 		Named("vclusterhealth").
 		Complete(r)
 }
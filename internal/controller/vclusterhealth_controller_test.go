@@ -17,138 +17,252 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+	"github.com/vrahul1997/vcluster-health-mirror/internal/translate"
 )
 
+// signalsOf builds one anonymous signal result per boolean.
+func signalsOf(present ...bool) []fleetv1alpha1.SignalResult {
+	out := make([]fleetv1alpha1.SignalResult, 0, len(present))
+	for i, p := range present {
+		status := metav1.ConditionFalse
+		if p {
+			status = metav1.ConditionTrue
+		}
+		out = append(out, fleetv1alpha1.SignalResult{Name: fmt.Sprintf("signal-%d", i), Status: status})
+	}
+	return out
+}
+
 var _ = Describe("helper functions", func() {
 	Describe("computeScoreLevel", func() {
 		It("returns None and 0 when all signals are false", func() {
-			score, level := computeScoreLevel(false, false, false, false, false, false)
+			score, level := computeScoreLevel(signalsOf(false, false, false, false, false, false), nil)
 			Expect(score).To(Equal(int32(0)))
 			Expect(level).To(Equal("None"))
 		})
 
 		It("returns Partial when some signals are true", func() {
 			// 3/6 -> 50
-			score, level := computeScoreLevel(true, true, true, false, false, false)
+			score, level := computeScoreLevel(signalsOf(true, true, true, false, false, false), nil)
 			Expect(score).To(Equal(int32(50)))
 			Expect(level).To(Equal("Partial"))
 		})
 
 		It("uses integer rounding (5/6 = 83)", func() {
-			score, level := computeScoreLevel(true, true, true, true, true, false) // 5/6
+			score, level := computeScoreLevel(signalsOf(true, true, true, true, true, false), nil) // 5/6
 			Expect(score).To(Equal(int32(83)))
 			Expect(level).To(Equal("Partial"))
 		})
 
 		It("returns Full when all signals are true", func() {
-			score, level := computeScoreLevel(true, true, true, true, true, true)
+			score, level := computeScoreLevel(signalsOf(true, true, true, true, true, true), nil)
+			Expect(score).To(Equal(int32(100)))
+			Expect(level).To(Equal("Full"))
+		})
+
+		It("treats Unknown as not present", func() {
+			signals := signalsOf(true, true)
+			signals[1].Status = metav1.ConditionUnknown
+			score, level := computeScoreLevel(signals, nil)
+			Expect(score).To(Equal(int32(50)))
+			Expect(level).To(Equal("Partial"))
+		})
+
+		It("scores every evaluated signal but skips disabled checks", func() {
+			signals := signalsOf(true, true)
+			signals[1].Status = metav1.ConditionFalse
+			for _, name := range []string{SignalControlPlaneStable, SignalBackingStoreHealthy, SignalReleaseHealthy} {
+				signals[1].Name = name
+				score, level := computeScoreLevel(signals, nil)
+				Expect(score).To(Equal(int32(50)), name)
+				Expect(level).To(Equal("Partial"), name)
+			}
+
+			signals[1] = fleetv1alpha1.SignalResult{Name: SignalReleaseHealthy, Status: metav1.ConditionUnknown, Reason: "CheckDisabled"}
+			score, level := computeScoreLevel(signals, nil)
 			Expect(score).To(Equal(int32(100)))
 			Expect(level).To(Equal("Full"))
 		})
+
+		It("returns None and 0 when no detectors are registered", func() {
+			score, level := computeScoreLevel(nil, nil)
+			Expect(score).To(Equal(int32(0)))
+			Expect(level).To(Equal("None"))
+		})
 	})
 
-	Describe("isControlPlaneReady", func() {
-		It("returns true when <name>-0 is Running and Ready in the correct namespace", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster"},
-					Status: corev1.PodStatus{
-						Phase: corev1.PodRunning,
-						Conditions: []corev1.PodCondition{{
-							Type:   corev1.PodReady,
-							Status: corev1.ConditionTrue,
-						}},
-					},
+	Describe("computeScoreLevel with a scoring policy", func() {
+		weight := func(w int32) *int32 { return &w }
+		levels := []fleetv1alpha1.LevelThreshold{
+			{Name: "Critical", MinScore: 0},
+			{Name: "Healthy", MinScore: 90},
+			{Name: "Degraded", MinScore: 50},
+		}
+
+		It("weights signals and picks the highest threshold reached", func() {
+			signals := signalsOf(true, true, false)
+			policy := &fleetv1alpha1.ScoringPolicy{
+				Signals: []fleetv1alpha1.SignalPolicy{
+					{Name: "signal-0", Weight: weight(8)},
+					{Name: "signal-2", Weight: weight(1)},
 				},
+				Levels: levels,
 			}
+			// (8+1)/(8+1+1) -> 90
+			score, level := computeScoreLevel(signals, policy)
+			Expect(score).To(Equal(int32(90)))
+			Expect(level).To(Equal("Healthy"))
+		})
 
-			Expect(isControlPlaneReady("vc-prod", "vcluster", pods)).To(BeTrue())
+		It("ignores zero-weight signals in the score", func() {
+			signals := signalsOf(true, false)
+			policy := &fleetv1alpha1.ScoringPolicy{
+				Signals: []fleetv1alpha1.SignalPolicy{{Name: "signal-1", Weight: weight(0)}},
+			}
+			score, level := computeScoreLevel(signals, policy)
+			Expect(score).To(Equal(int32(100)))
+			Expect(level).To(Equal("Full"))
 		})
 
-		It("returns false when pod is not Ready", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster"},
-					Status: corev1.PodStatus{
-						Phase: corev1.PodRunning,
-						Conditions: []corev1.PodCondition{{
-							Type:   corev1.PodReady,
-							Status: corev1.ConditionFalse,
-						}},
-					},
-				},
+		It("falls back to the lowest level when a required signal is missing", func() {
+			signals := signalsOf(true, true, true, false)
+			policy := &fleetv1alpha1.ScoringPolicy{
+				Signals: []fleetv1alpha1.SignalPolicy{{Name: "signal-3", Required: true}},
+				Levels:  levels,
 			}
+			score, level := computeScoreLevel(signals, policy)
+			Expect(score).To(Equal(int32(75)))
+			Expect(level).To(Equal("Critical"))
+		})
 
-			Expect(isControlPlaneReady("vc-prod", "vcluster", pods)).To(BeFalse())
+		It("returns None without custom levels when a required signal is missing", func() {
+			signals := signalsOf(true, false)
+			policy := &fleetv1alpha1.ScoringPolicy{
+				Signals: []fleetv1alpha1.SignalPolicy{{Name: "signal-1", Required: true}},
+			}
+			_, level := computeScoreLevel(signals, policy)
+			Expect(level).To(Equal("None"))
 		})
 
-		It("returns false when pod is in a different namespace", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster-1"},
-					Status:     corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+		It("uses the lowest level when no threshold is reached", func() {
+			policy := &fleetv1alpha1.ScoringPolicy{
+				Levels: []fleetv1alpha1.LevelThreshold{{Name: "Healthy", MinScore: 90}, {Name: "Degraded", MinScore: 50}},
+			}
+			_, level := computeScoreLevel(signalsOf(true, false, false), policy)
+			Expect(level).To(Equal("Degraded"))
+		})
+	})
+
+	Describe("controlPlaneDetector without owner", func() {
+		evaluate := func(pod *corev1.Pod) DetectorResult {
+			c := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster"}
+			return controlPlaneDetector{}.Evaluate(context.Background(), c, newTestSnapshot(pod))
+		}
+		pod := func(ns string, ready corev1.ConditionStatus) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: ns},
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
 				},
 			}
+		}
+
+		It("is true when <name>-0 is Running and Ready in the correct namespace", func() {
+			Expect(evaluate(pod("vcluster", corev1.ConditionTrue)).Status).To(Equal(metav1.ConditionTrue))
+		})
 
-			Expect(isControlPlaneReady("vc-prod", "vcluster", pods)).To(BeFalse())
+		It("is false when the pod is not Ready", func() {
+			Expect(evaluate(pod("vcluster", corev1.ConditionFalse)).Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("is false when the pod is in a different namespace", func() {
+			res := evaluate(pod("vcluster-1", corev1.ConditionTrue))
+			Expect(res.Status).To(Equal(metav1.ConditionFalse))
+			Expect(res.Reason).To(Equal("PodNotFound"))
 		})
 	})
 
-	Describe("hasDNSSync", func() {
-		It("returns true when kube-dns mapping Service exists in the same namespace", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns-x-kube-system-x-vc-prod", Namespace: "vcluster"}},
-			}
-			Expect(hasDNSSync("vc-prod", "vcluster", svcs)).To(BeTrue())
+	Describe("dnsSyncDetector", func() {
+		evaluate := func(name string, objs ...client.Object) DetectorResult {
+			c := fleetv1alpha1.DiscoveredCluster{Name: name, Namespace: "vcluster"}
+			return dnsSyncDetector{}.Evaluate(context.Background(), c, newTestSnapshot(objs...))
+		}
+		service := func(name, ns string) *corev1.Service {
+			return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}}
+		}
+
+		It("is true when the kube-dns mapping Service exists in the same namespace", func() {
+			Expect(evaluate("vc-prod", service("kube-dns-x-kube-system-x-vc-prod", "vcluster")).Status).To(Equal(metav1.ConditionTrue))
 		})
 
-		It("returns false when kube-dns mapping Service exists but in a different namespace", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns-x-kube-system-x-vc-prod", Namespace: "vcluster-1"}},
-			}
-			Expect(hasDNSSync("vc-prod", "vcluster", svcs)).To(BeFalse())
+		It("is false when the kube-dns mapping Service is in a different namespace", func() {
+			Expect(evaluate("vc-prod", service("kube-dns-x-kube-system-x-vc-prod", "vcluster-1")).Status).To(Equal(metav1.ConditionFalse))
 		})
 
-		It("returns false when no kube-dns mapping Service matches", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "some-other-service", Namespace: "vcluster"}},
-			}
-			Expect(hasDNSSync("vc-prod", "vcluster", svcs)).To(BeFalse())
+		It("does not match another vCluster whose name extends this one", func() {
+			Expect(evaluate("vc-prod", service("kube-dns-x-kube-system-x-vc-prod-2", "vcluster")).Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("matches the hashed name of a long vCluster name", func() {
+			long := "vc-" + strings.Repeat("p", 40)
+			svc := service(translate.HostName("kube-dns", "kube-system", long), "vcluster")
+			Expect(svc.Name).To(HaveLen(translate.MaxNameLength))
+			Expect(evaluate(long, svc).Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("is false when no kube-dns mapping Service matches", func() {
+			res := evaluate("vc-prod", service("some-other-service", "vcluster"))
+			Expect(res.Status).To(Equal(metav1.ConditionFalse))
+			Expect(res.Evidence).To(Equal("no Service kube-dns-x-kube-system-x-vc-prod in namespace vcluster"))
 		})
 	})
 
-	Describe("hasNodeSync", func() {
-		It("returns true when node-mapping Service exists in the same namespace", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-node-k3d-k3s-default-server-0", Namespace: "vcluster"}},
-			}
-			Expect(hasNodeSync("vc-prod", "vcluster", svcs)).To(BeTrue())
+	Describe("nodeSyncDetector", func() {
+		evaluate := func(svcs ...client.Object) DetectorResult {
+			c := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster"}
+			return nodeSyncDetector{}.Evaluate(context.Background(), c, newTestSnapshot(svcs...))
+		}
+		service := func(name, ns string) *corev1.Service {
+			return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}}
+		}
+
+		It("is true when a node-mapping Service exists in the same namespace", func() {
+			res := evaluate(service("vc-prod-node-k3d-k3s-default-server-0", "vcluster"))
+			Expect(res.Status).To(Equal(metav1.ConditionTrue))
+			Expect(res.Evidence).To(Equal("1 Services matching vc-prod-node-* in namespace vcluster"))
 		})
 
-		It("returns false when node-mapping Service exists but in a different namespace", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-node-k3d-k3s-default-server-0", Namespace: "vcluster-1"}},
-			}
-			Expect(hasNodeSync("vc-prod", "vcluster", svcs)).To(BeFalse())
+		It("is false when the node-mapping Service is in a different namespace", func() {
+			Expect(evaluate(service("vc-prod-node-k3d-k3s-default-server-0", "vcluster-1")).Status).To(Equal(metav1.ConditionFalse))
 		})
 
-		It("returns false when no node-mapping Service matches", func() {
-			svcs := []corev1.Service{
-				{ObjectMeta: metav1.ObjectMeta{Name: "vc-prod", Namespace: "vcluster"}},
-			}
-			Expect(hasNodeSync("vc-prod", "vcluster", svcs)).To(BeFalse())
+		It("does not match node Services of a vCluster whose name contains this one", func() {
+			res := evaluate(service("my-vc-prod-node-worker-1", "vcluster"), service("vc-prod-2-node-worker-1", "vcluster"))
+			Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("is false when no node-mapping Service matches", func() {
+			Expect(evaluate(service("vc-prod", "vcluster")).Status).To(Equal(metav1.ConditionFalse))
 		})
 	})
 
-	Describe("workloadSyncSplit", func() {
-		It("returns system=true, tenant=false when only kube-system pods are synced", func() {
+	Describe("workloadSummary", func() {
+		It("counts only kube-system pods as system and skips control-plane pods", func() {
 			pods := []corev1.Pod{
-				// control-plane pod should be ignored
+				// control-plane pods should be ignored
 				{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "vc-dev-0",
@@ -157,6 +271,14 @@ var _ = Describe("helper functions", func() {
 					},
 					Status: corev1.PodStatus{Phase: corev1.PodRunning},
 				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vc-dev-syncer",
+						Namespace: "vcluster-1",
+						Labels:    map[string]string{"app": "vcluster", "vcluster.loft.sh/managed-by": "vc-dev"},
+					},
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+				},
 				// synced system pod
 				{
 					ObjectMeta: metav1.ObjectMeta{
@@ -171,12 +293,12 @@ var _ = Describe("helper functions", func() {
 				},
 			}
 
-			system, tenant := workloadSyncSplit("vc-dev", "vcluster-1", pods)
-			Expect(system).To(BeTrue())
-			Expect(tenant).To(BeFalse())
+			st := workloadSummary("vc-dev", "vcluster-1", pods)
+			Expect(st.System.Total).To(BeEquivalentTo(1))
+			Expect(st.Tenant.Total).To(BeZero())
 		})
 
-		It("returns tenant=true when a non-kube-system workload is synced", func() {
+		It("counts a non-kube-system workload as tenant", func() {
 			pods := []corev1.Pod{
 				{
 					ObjectMeta: metav1.ObjectMeta{
@@ -191,9 +313,9 @@ var _ = Describe("helper functions", func() {
 				},
 			}
 
-			system, tenant := workloadSyncSplit("vc-prod", "vcluster", pods)
-			Expect(system).To(BeFalse())
-			Expect(tenant).To(BeTrue())
+			st := workloadSummary("vc-prod", "vcluster", pods)
+			Expect(st.System.Total).To(BeZero())
+			Expect(st.Tenant.Total).To(BeEquivalentTo(1))
 		})
 
 		It("treats unknown original namespace as tenant (conservative)", func() {
@@ -211,58 +333,9 @@ var _ = Describe("helper functions", func() {
 				},
 			}
 
-			system, tenant := workloadSyncSplit("vc-prod", "vcluster", pods)
-			Expect(system).To(BeFalse())
-			Expect(tenant).To(BeTrue())
-		})
-	})
-
-	Describe("hasWorkloadSync (legacy)", func() {
-		It("returns false when only control-plane pod exists", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
-					Status:     corev1.PodStatus{Phase: corev1.PodRunning},
-				},
-			}
-			Expect(hasWorkloadSync("vc-prod", "vcluster", pods)).To(BeFalse())
-		})
-
-		It("returns true when a synced workload pod has managed-by label", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "nginx-x-default-x-vc-prod",
-						Namespace: "vcluster",
-						Labels: map[string]string{
-							"vcluster.loft.sh/managed-by": "vc-prod",
-							"vcluster.loft.sh/namespace":  "default",
-						},
-					},
-					Status: corev1.PodStatus{Phase: corev1.PodRunning},
-				},
-			}
-			Expect(hasWorkloadSync("vc-prod", "vcluster", pods)).To(BeTrue())
-		})
-
-		It("returns true via namespace-pattern fallback when labels are missing", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "random", Namespace: "team-a-x-vc-prod"},
-					Status:     corev1.PodStatus{Phase: corev1.PodRunning},
-				},
-			}
-			Expect(hasWorkloadSync("vc-prod", "vcluster", pods)).To(BeTrue())
-		})
-
-		It("ignores app=vcluster pods that are not the statefulset pod", func() {
-			pods := []corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-syncer", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster"}},
-					Status:     corev1.PodStatus{Phase: corev1.PodRunning},
-				},
-			}
-			Expect(hasWorkloadSync("vc-prod", "vcluster", pods)).To(BeFalse())
+			st := workloadSummary("vc-prod", "vcluster", pods)
+			Expect(st.System.Total).To(BeZero())
+			Expect(st.Tenant.Total).To(BeEquivalentTo(1))
 		})
 	})
 })
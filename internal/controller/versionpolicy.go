@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// SignalUpToDate reports whether a vCluster's version satisfies spec.versionPolicy. It is
+// registered only when a version policy is set.
+const SignalUpToDate = "upToDate"
+
+// versionPolicy is the compiled spec.versionPolicy.
+type versionPolicy struct {
+	constraint *semver.Constraints
+	denied     []*semver.Version
+}
+
+// versionPolicyFor compiles spec.versionPolicy, or returns nil if it is not set.
+func versionPolicyFor(spec fleetv1alpha1.VClusterHealthSpec) (*versionPolicy, error) {
+	vp := spec.VersionPolicy
+	if vp == nil {
+		return nil, nil
+	}
+	p := &versionPolicy{}
+	if vp.Constraint != "" {
+		c, err := semver.NewConstraint(vp.Constraint)
+		if err != nil {
+			return nil, fmt.Errorf("spec.versionPolicy.constraint: %w", err)
+		}
+		p.constraint = c
+	}
+	for i, d := range vp.Denied {
+		v, err := semver.NewVersion(d)
+		if err != nil {
+			return nil, fmt.Errorf("spec.versionPolicy.denied[%d]: %w", i, err)
+		}
+		p.denied = append(p.denied, v)
+	}
+	return p, nil
+}
+
+// clusterVersion returns the vCluster version of c: the one read from the control-plane image,
+// else the Helm release's appVersion.
+func clusterVersion(c fleetv1alpha1.DiscoveredCluster) string {
+	if c.VClusterVersion != "" {
+		return c.VClusterVersion
+	}
+	if c.Release != nil {
+		return c.Release.AppVersion
+	}
+	return ""
+}
+
+// upToDateDetector checks the vCluster version against the snapshot's version policy.
+type upToDateDetector struct{}
+
+func (upToDateDetector) Name() string { return SignalUpToDate }
+
+func (upToDateDetector) Evaluate(_ context.Context, c fleetv1alpha1.DiscoveredCluster, snap *HostSnapshot) DetectorResult {
+	p := snap.versionPolicy
+	if p == nil {
+		return DetectorResult{Status: metav1.ConditionUnknown, Reason: "PolicyDisabled", Evidence: "spec.versionPolicy is not set"}
+	}
+	raw := clusterVersion(c)
+	if raw == "" {
+		return DetectorResult{Status: metav1.ConditionUnknown, Reason: "VersionUnknown", Evidence: "no vCluster version detected"}
+	}
+	v, err := semver.NewVersion(raw)
+	if err != nil {
+		return DetectorResult{Status: metav1.ConditionUnknown, Reason: "VersionUnknown", Evidence: fmt.Sprintf("version %q is not semver", raw)}
+	}
+	for _, d := range p.denied {
+		if v.Equal(d) {
+			return DetectorResult{
+				Status:   metav1.ConditionFalse,
+				Reason:   "VersionDenied",
+				Evidence: fmt.Sprintf("vCluster %s is listed in spec.versionPolicy.denied", raw),
+			}
+		}
+	}
+	if p.constraint != nil {
+		if ok, errs := p.constraint.Validate(v); !ok {
+			return DetectorResult{
+				Status:   metav1.ConditionFalse,
+				Reason:   "ConstraintNotMet",
+				Evidence: fmt.Sprintf("vCluster %s does not satisfy %q: %s", raw, p.constraint, joinErrors(errs)),
+			}
+		}
+	}
+	return DetectorResult{Status: metav1.ConditionTrue, Reason: "VersionAllowed", Evidence: fmt.Sprintf("vCluster %s is within spec.versionPolicy", raw)}
+}
+
+// joinErrors joins error messages with "; ".
+func joinErrors(errs []error) string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	if len(msgs) == 0 {
+		return "constraint not met"
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// countOutOfPolicy counts the clusters whose upToDate signal is False.
+func countOutOfPolicy(coverage []fleetv1alpha1.SyncCoverage) int32 {
+	n := int32(0)
+	for _, cov := range coverage {
+		for _, s := range cov.Signals {
+			if s.Name == SignalUpToDate && s.Status == metav1.ConditionFalse {
+				n++
+			}
+		}
+	}
+	return n
+}
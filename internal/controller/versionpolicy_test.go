@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("version policy", func() {
+	ctx := context.Background()
+	policySpec := func(constraint string, denied ...string) fleetv1alpha1.VClusterHealthSpec {
+		return fleetv1alpha1.VClusterHealthSpec{
+			Namespace:     "vcluster",
+			VersionPolicy: &fleetv1alpha1.VersionPolicySpec{Constraint: constraint, Denied: denied},
+		}
+	}
+	evaluate := func(spec fleetv1alpha1.VClusterHealthSpec, c fleetv1alpha1.DiscoveredCluster) DetectorResult {
+		p, err := versionPolicyFor(spec)
+		Expect(err).NotTo(HaveOccurred())
+		snap := newTestSnapshot()
+		snap.versionPolicy = p
+		return upToDateDetector{}.Evaluate(ctx, c, snap)
+	}
+	cluster := func(version string) fleetv1alpha1.DiscoveredCluster {
+		return fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", VClusterVersion: version}
+	}
+
+	It("compiles the policy and rejects invalid entries", func() {
+		p, err := versionPolicyFor(fleetv1alpha1.VClusterHealthSpec{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p).To(BeNil())
+
+		_, err = versionPolicyFor(policySpec(">= banana"))
+		Expect(err).To(MatchError(ContainSubstring("spec.versionPolicy.constraint")))
+		_, err = versionPolicyFor(policySpec("", "0.19.3", "latest"))
+		Expect(err).To(MatchError(ContainSubstring("spec.versionPolicy.denied[1]")))
+	})
+
+	It("reports the constraint a version fails", func() {
+		spec := policySpec(">= 0.19.0, < 0.22.0", "0.20.1")
+
+		res := evaluate(spec, cluster("0.15.0"))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("ConstraintNotMet"))
+		Expect(res.Evidence).To(Equal(`vCluster 0.15.0 does not satisfy ">=0.19.0 <0.22.0": 0.15.0 is less than 0.19.0`))
+
+		res = evaluate(spec, cluster("0.20.1"))
+		Expect(res.Reason).To(Equal("VersionDenied"))
+
+		res = evaluate(spec, cluster("0.20.0"))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("VersionAllowed"))
+	})
+
+	It("falls back to the Helm appVersion and reports unknown versions", func() {
+		spec := policySpec(">= 0.19.0")
+		c := cluster("")
+		Expect(evaluate(spec, c).Reason).To(Equal("VersionUnknown"))
+
+		c.Release = &fleetv1alpha1.HelmRelease{AppVersion: "0.18.2"}
+		Expect(evaluate(spec, c).Reason).To(Equal("ConstraintNotMet"))
+
+		Expect(evaluate(spec, cluster("nightly")).Status).To(Equal(metav1.ConditionUnknown))
+	})
+
+	It("counts out-of-policy clusters on the fleet", func() {
+		controlPlane := func(name, image string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-0", Namespace: "vcluster", Labels: map[string]string{"app": "vcluster", "release": name}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "syncer", Image: image}}},
+			}
+		}
+		vcluster := map[string]string{"app": "vcluster"}
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "versions", Namespace: "default"},
+			Spec:       policySpec(">= 0.19.0"),
+		}
+		got, err := reconcileFleet(vh,
+			apiService("vc-old", "vcluster", vcluster), controlPlane("vc-old", "loftsh/vcluster:0.15.0"),
+			apiService("vc-new", "vcluster", vcluster), controlPlane("vc-new", "ghcr.io/loft-sh/vcluster-pro:0.20.0"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.OutOfPolicyClusters).To(HaveValue(BeEquivalentTo(1)))
+		Expect(testutil.ToFloat64(outOfPolicyGauge.WithLabelValues("default/versions"))).To(Equal(1.0))
+		forgetFleetMetrics("default/versions")
+	})
+
+	It("marks the fleet Stalled on an invalid policy", func() {
+		got, err := reconcileFleet(&fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       policySpec("~>> 1"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.FindStatusCondition(got.Status.Conditions, fleetv1alpha1.ConditionStalled).Reason).
+			To(Equal(ReasonInvalidVersionPolicy))
+	})
+})
@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// VolumeUsage is the usage of one mounted PersistentVolumeClaim.
+type VolumeUsage struct {
+	UsedBytes     uint64
+	CapacityBytes uint64
+}
+
+// VolumeStatsSource reports PersistentVolumeClaim usage, which the API objects do not carry.
+type VolumeStatsSource interface {
+	// VolumeUsage returns the usage of the claims mounted by pods on node.
+	VolumeUsage(ctx context.Context, node string) (map[types.NamespacedName]VolumeUsage, error)
+}
+
+// kubeletVolumeStats reads the kubelet stats summary through the API server's node proxy.
+// It needs get on nodes/proxy (config/rbac/volume_stats_role.yaml).
+type kubeletVolumeStats struct {
+	client rest.Interface
+}
+
+// NewKubeletVolumeStats returns a VolumeStatsSource backed by the kubelet stats summary.
+func NewKubeletVolumeStats(cfg *rest.Config) (VolumeStatsSource, error) {
+	cs, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeletVolumeStats{client: cs.CoreV1().RESTClient()}, nil
+}
+
+// statsSummary is the subset of the kubelet /stats/summary response that is read.
+type statsSummary struct {
+	Pods []struct {
+		Volume []struct {
+			PVCRef *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef,omitempty"`
+			UsedBytes     *uint64 `json:"usedBytes,omitempty"`
+			CapacityBytes *uint64 `json:"capacityBytes,omitempty"`
+		} `json:"volume,omitempty"`
+	} `json:"pods"`
+}
+
+func (k *kubeletVolumeStats) VolumeUsage(ctx context.Context, node string) (map[types.NamespacedName]VolumeUsage, error) {
+	raw, err := k.client.Get().Resource("nodes").Name(node).SubResource("proxy").Suffix("stats/summary").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseVolumeUsage(raw)
+}
+
+// parseVolumeUsage extracts claim usage from a kubelet stats summary.
+func parseVolumeUsage(raw []byte) (map[types.NamespacedName]VolumeUsage, error) {
+	var summary statsSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, err
+	}
+	out := map[types.NamespacedName]VolumeUsage{}
+	for _, pod := range summary.Pods {
+		for _, v := range pod.Volume {
+			if v.PVCRef == nil || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			out[types.NamespacedName{Namespace: v.PVCRef.Namespace, Name: v.PVCRef.Name}] = VolumeUsage{UsedBytes: *v.UsedBytes, CapacityBytes: *v.CapacityBytes}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+// DefaultWatchDebounce is how long Pod/Service events wait before triggering a reconcile.
+// Events arriving while a reconcile is already pending collapse into it.
+const DefaultWatchDebounce = 5 * time.Second
+
+// targetNamespace returns the namespace vClusters are discovered in, and whether discovery is cluster-wide.
+// - default: "vcluster"
+// - "*" or "all": discover vClusters across all namespaces
+func targetNamespace(spec fleetv1alpha1.VClusterHealthSpec) (string, bool) {
+	ns := spec.Namespace
+	if ns == "" {
+		ns = "vcluster"
+	}
+	return ns, ns == "*" || ns == "all"
+}
+
+// fleetsForObject maps a host Pod or Service to every VClusterHealth whose namespace selection covers it.
+func (r *VClusterHealthReconciler) fleetsForObject(ctx context.Context, obj client.Object) []reconcile.Request {
+	var fleets fleetv1alpha1.VClusterHealthList
+	if err := r.List(ctx, &fleets); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list VClusterHealth for watch event",
+			"object", types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+		return nil
+	}
+
+	var reqs []reconcile.Request
+	var nsLabels map[string]string
+	nsLoaded := false
+	for _, vh := range fleets.Items {
+		m, err := newNamespaceMatcher(vh.Spec)
+		if err != nil {
+			continue // reported as Stalled by Reconcile
+		}
+		if m.needsLabels() && !nsLoaded {
+			var ns corev1.Namespace
+			if err := r.Get(ctx, client.ObjectKey{Name: obj.GetNamespace()}, &ns); err == nil {
+				nsLabels = ns.Labels
+			}
+			nsLoaded = true
+		}
+		if m.matches(obj.GetNamespace(), nsLabels) {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&vh)})
+		}
+	}
+	return reqs
+}
+
+// debouncedEnqueue returns an event handler that maps objects to requests and enqueues them after delay.
+// The workqueue keeps a single pending entry per request, so a burst of events within delay
+// results in one reconcile.
+func debouncedEnqueue(mapFn handler.MapFunc, delay time.Duration) handler.EventHandler {
+	add := func(ctx context.Context, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		for _, req := range mapFn(ctx, obj) {
+			q.AddAfter(req, delay)
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(ctx, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(ctx, e.ObjectNew, q)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(ctx, e.Object, q)
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(ctx, e.Object, q)
+		},
+	}
+}
+
+// podHealthChanged filters Pod updates down to the fields detectors read:
+// labels, phase, readiness and deletion.
+func podHealthChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, ok1 := e.ObjectOld.(*corev1.Pod)
+			newPod, ok2 := e.ObjectNew.(*corev1.Pod)
+			if !ok1 || !ok2 {
+				return true
+			}
+			if !maps.Equal(oldPod.Labels, newPod.Labels) {
+				return true
+			}
+			if oldPod.DeletionTimestamp.IsZero() != newPod.DeletionTimestamp.IsZero() {
+				return true
+			}
+			if oldPod.Status.Phase != newPod.Status.Phase {
+				return true
+			}
+			return podReady(oldPod) != podReady(newPod)
+		},
+	}
+}
+
+// endpointReadinessChanged filters EndpointSlice updates down to what APIEndpoints reads:
+// ports, and which endpoints exist and are ready. Address churn alone is ignored.
+func endpointReadinessChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSlice, ok1 := e.ObjectOld.(*discoveryv1.EndpointSlice)
+			newSlice, ok2 := e.ObjectNew.(*discoveryv1.EndpointSlice)
+			if !ok1 || !ok2 {
+				return true
+			}
+			if !equality.Semantic.DeepEqual(oldSlice.Ports, newSlice.Ports) {
+				return true
+			}
+			return !maps.Equal(sliceReadiness(oldSlice), sliceReadiness(newSlice))
+		},
+	}
+}
+
+// sliceReadiness maps each endpoint of the slice to its readiness.
+func sliceReadiness(s *discoveryv1.EndpointSlice) map[string]bool {
+	out := make(map[string]bool, len(s.Endpoints))
+	for _, ep := range s.Endpoints {
+		out[endpointKey(ep)] = endpointReady(ep)
+	}
+	return out
+}
+
+// podReady reports whether the pod's Ready condition is True.
+func podReady(p *corev1.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
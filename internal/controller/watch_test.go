@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+)
+
+var _ = Describe("watch mapping", func() {
+	fleet := func(name, ns string) *fleetv1alpha1.VClusterHealth {
+		return &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: ns},
+		}
+	}
+
+	It("enqueues every fleet whose namespace selection covers the object", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(newFakeScheme()).
+			WithObjects(fleet("default-ns", ""), fleet("all", "*"), fleet("other", "team-a")).
+			Build()
+		r := &VClusterHealthReconciler{Client: c}
+
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster"}}
+		reqs := r.fleetsForObject(context.Background(), pod)
+		Expect(reqs).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "default-ns"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "all"}},
+		))
+	})
+
+	It("collapses a burst of events into one pending request", func() {
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "fleet"}}
+		h := debouncedEnqueue(func(context.Context, client.Object) []reconcile.Request {
+			return []reconcile.Request{req}
+		}, 50*time.Millisecond)
+
+		q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer q.ShutDown()
+
+		for i := range 500 {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i), Namespace: "vcluster"}}
+			h.Create(context.Background(), event.CreateEvent{Object: pod}, q)
+		}
+		Expect(q.Len()).To(Equal(0))
+		Eventually(q.Len).Should(Equal(1))
+		Consistently(q.Len, 100*time.Millisecond).Should(Equal(1))
+	})
+
+	It("only passes Pod updates that change health-relevant fields", func() {
+		p := podHealthChanged()
+		base := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-prod-0", Namespace: "vcluster", ResourceVersion: "1"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+
+		bumped := base.DeepCopy()
+		bumped.ResourceVersion = "2"
+		Expect(p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: bumped})).To(BeFalse())
+
+		ready := base.DeepCopy()
+		ready.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		Expect(p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: ready})).To(BeTrue())
+
+		relabelled := base.DeepCopy()
+		relabelled.Labels = map[string]string{"vcluster.loft.sh/managed-by": "vc-prod"}
+		Expect(p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: relabelled})).To(BeTrue())
+	})
+})
@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+	"github.com/vrahul1997/vcluster-health-mirror/internal/translate"
+)
+
+// labelVirtualNamespace is the label vCluster sets to the original namespace on synced pods.
+const labelVirtualNamespace = "vcluster.loft.sh/namespace"
+
+// DefaultMinHealthyPercent is the share of synced pods that must be healthy for a workload
+// signal when spec.workloads.minHealthyPercent is unset.
+const DefaultMinHealthyPercent = 50
+
+// maxWorkloadNamespaces bounds WorkloadsStatus.Namespaces; it matches the CRD's MaxItems.
+const maxWorkloadNamespaces = 20
+
+// minHealthyPercentFor returns the healthy-pod threshold from spec.workloads.
+func minHealthyPercentFor(spec fleetv1alpha1.VClusterHealthSpec) int32 {
+	if w := spec.Workloads; w != nil && w.MinHealthyPercent != nil {
+		return *w.MinHealthyPercent
+	}
+	return DefaultMinHealthyPercent
+}
+
+// WorkloadState counts the synced pods of one vCluster.
+type WorkloadState struct {
+	System fleetv1alpha1.WorkloadCounts
+	Tenant fleetv1alpha1.WorkloadCounts
+	// Namespaces counts pods by original namespace; pods without the label are only in Tenant.
+	Namespaces map[string]*fleetv1alpha1.WorkloadCounts
+}
+
+// Status returns the state as reported in SyncCoverage, keeping the largest namespaces.
+func (s *WorkloadState) Status() *fleetv1alpha1.WorkloadsStatus {
+	st := &fleetv1alpha1.WorkloadsStatus{System: s.System, Tenant: s.Tenant}
+	for ns, counts := range s.Namespaces {
+		st.Namespaces = append(st.Namespaces, fleetv1alpha1.NamespaceWorkloads{Namespace: ns, WorkloadCounts: *counts})
+	}
+	slices.SortFunc(st.Namespaces, func(a, b fleetv1alpha1.NamespaceWorkloads) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+	if len(st.Namespaces) > maxWorkloadNamespaces {
+		st.OmittedNamespaces = int32(len(st.Namespaces) - maxWorkloadNamespaces)
+		st.Namespaces = st.Namespaces[:maxWorkloadNamespaces]
+	}
+	return st
+}
+
+// inVClusterNamespace reports whether p lives where the vCluster vclusterName in
+// controlPlaneNamespace syncs pods to: its own namespace or, in multi-namespace mode, a host
+// namespace translated from p's original namespace.
+func inVClusterNamespace(p *corev1.Pod, vclusterName, controlPlaneNamespace string) bool {
+	if p.Namespace == controlPlaneNamespace {
+		return true
+	}
+	if ns := p.Labels[labelVirtualNamespace]; ns != "" && translate.HostNamespace(ns, vclusterName) == p.Namespace {
+		return true
+	}
+	_, ok := translate.VirtualNamespace(p.Namespace, vclusterName)
+	return ok
+}
+
+// workloadSummary counts the pods synced by vclusterName in controlPlaneNamespace. System pods are
+// those whose original namespace (vcluster.loft.sh/namespace) is kube-system; every other pod,
+// including one without the label, is a tenant pod. Control-plane pods (app=vcluster), the
+// StatefulSet pod (<name>-0) and pods of a same-named vCluster in another namespace are excluded.
+func workloadSummary(vclusterName, controlPlaneNamespace string, pods []corev1.Pod) *WorkloadState {
+	controlPlanePod := vclusterName + "-0"
+	st := &WorkloadState{Namespaces: map[string]*fleetv1alpha1.WorkloadCounts{}}
+
+	for i := range pods {
+		p := &pods[i]
+		if p.Namespace == controlPlaneNamespace && p.Name == controlPlanePod {
+			continue
+		}
+		if p.Labels["app"] == "vcluster" {
+			continue
+		}
+		if !slices.ContainsFunc(vclusterOwnerLabelKeys, func(k string) bool { return p.Labels[k] == vclusterName }) {
+			continue
+		}
+		if !inVClusterNamespace(p, vclusterName, controlPlaneNamespace) {
+			continue
+		}
+
+		origNS := p.Labels[labelVirtualNamespace]
+		if origNS == "kube-system" {
+			countPod(&st.System, p)
+		} else {
+			countPod(&st.Tenant, p)
+		}
+		if origNS == "" {
+			continue
+		}
+		counts, ok := st.Namespaces[origNS]
+		if !ok {
+			counts = &fleetv1alpha1.WorkloadCounts{}
+			st.Namespaces[origNS] = counts
+		}
+		countPod(counts, p)
+	}
+	return st
+}
+
+// countPod adds p to c by phase and readiness.
+func countPod(c *fleetv1alpha1.WorkloadCounts, p *corev1.Pod) {
+	c.Total++
+	switch p.Status.Phase {
+	case corev1.PodRunning:
+		c.Running++
+		if podReady(p) {
+			c.Ready++
+		}
+	case corev1.PodPending:
+		c.Pending++
+	case corev1.PodSucceeded:
+		c.Succeeded++
+	case corev1.PodFailed:
+		c.Failed++
+	default:
+		c.Unknown++
+	}
+}
+
+// healthyPercent is the share of pods in c that are Ready or Succeeded, rounded down.
+func healthyPercent(c fleetv1alpha1.WorkloadCounts) int32 {
+	if c.Total == 0 {
+		return 0
+	}
+	return (c.Ready + c.Succeeded) * 100 / c.Total
+}
+
+// describeCounts formats the non-zero phases of c, e.g. "9 running (8 ready), 1 pending".
+func describeCounts(c fleetv1alpha1.WorkloadCounts) string {
+	parts := []string{fmt.Sprintf("%d running (%d ready)", c.Running, c.Ready)}
+	for _, p := range []struct {
+		n     int32
+		phase string
+	}{{c.Pending, "pending"}, {c.Succeeded, "succeeded"}, {c.Failed, "failed"}, {c.Unknown, "unknown"}} {
+		if p.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", p.n, p.phase))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Workloads counts the pods synced by c. The result is memoised.
+func (s *HostSnapshot) Workloads(ctx context.Context, c fleetv1alpha1.DiscoveredCluster) (*WorkloadState, error) {
+	key := c.Namespace + "/" + c.Name
+	if st, ok := s.workloads[key]; ok {
+		return st, nil
+	}
+	pods, err := s.PodsForVCluster(ctx, c.Namespace, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	st := workloadSummary(c.Name, c.Namespace, pods)
+	s.workloads[key] = st
+	return st, nil
+}
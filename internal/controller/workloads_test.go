@@ -0,0 +1,158 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1alpha1 "github.com/vrahul1997/vcluster-health-mirror/api/v1alpha1"
+	"github.com/vrahul1997/vcluster-health-mirror/internal/translate"
+)
+
+var _ = Describe("workload counts", func() {
+	ctx := context.Background()
+	cluster := fleetv1alpha1.DiscoveredCluster{Name: "vc-prod", Namespace: "vcluster", ServiceName: "vc-prod", ServicePort: 443}
+
+	syncedPod := func(name, origNS string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+		lbls := map[string]string{"vcluster.loft.sh/managed-by": "vc-prod"}
+		if origNS != "" {
+			lbls[labelVirtualNamespace] = origNS
+		}
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "vcluster", Labels: lbls},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+		if ready {
+			p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return p
+	}
+	tenantPods := func(running, pending int) []client.Object {
+		var objs []client.Object
+		for i := range running {
+			objs = append(objs, syncedPod(fmt.Sprintf("web-%d-x-default-x-vc-prod", i), "default", corev1.PodRunning, true))
+		}
+		for i := range pending {
+			objs = append(objs, syncedPod(fmt.Sprintf("job-%d-x-batch-x-vc-prod", i), "batch", corev1.PodPending, false))
+		}
+		return objs
+	}
+
+	It("counts synced pods by phase, readiness and original namespace", func() {
+		wl, err := newTestSnapshot(
+			syncedPod("coredns-x-kube-system-x-vc-prod", "kube-system", corev1.PodRunning, true),
+			syncedPod("web-x-default-x-vc-prod", "default", corev1.PodRunning, false),
+			syncedPod("migrate-x-default-x-vc-prod", "default", corev1.PodSucceeded, false),
+			syncedPod("api-x-shop-x-vc-prod", "shop", corev1.PodFailed, false),
+			syncedPod("legacy", "", corev1.PodPending, false),
+		).Workloads(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		st := wl.Status()
+		Expect(st.System).To(Equal(fleetv1alpha1.WorkloadCounts{Total: 1, Running: 1, Ready: 1}))
+		Expect(st.Tenant).To(Equal(fleetv1alpha1.WorkloadCounts{Total: 4, Running: 1, Pending: 1, Succeeded: 1, Failed: 1}))
+		Expect(st.Namespaces).To(Equal([]fleetv1alpha1.NamespaceWorkloads{
+			{Namespace: "default", WorkloadCounts: fleetv1alpha1.WorkloadCounts{Total: 2, Running: 1, Succeeded: 1}},
+			{Namespace: "kube-system", WorkloadCounts: fleetv1alpha1.WorkloadCounts{Total: 1, Running: 1, Ready: 1}},
+			{Namespace: "shop", WorkloadCounts: fleetv1alpha1.WorkloadCounts{Total: 1, Failed: 1}},
+		}))
+	})
+
+	It("keeps same-named vClusters in different namespaces apart", func() {
+		inNamespace := func(p *corev1.Pod, ns string) *corev1.Pod {
+			p.Namespace = ns
+			return p
+		}
+		snap := newTestSnapshot(
+			syncedPod("web-x-default-x-vc-prod", "default", corev1.PodRunning, true),
+			inNamespace(syncedPod("web-x-default-x-vc-prod", "default", corev1.PodPending, false), "team-b"),
+			inNamespace(syncedPod("api-x-shop-x-vc-prod", "shop", corev1.PodPending, false), "team-b"),
+		)
+
+		wl, err := snap.Workloads(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wl.Tenant).To(Equal(fleetv1alpha1.WorkloadCounts{Total: 1, Running: 1, Ready: 1}))
+		Expect(wl.Namespaces).NotTo(HaveKey("shop"))
+
+		other := cluster
+		other.Namespace = "team-b"
+		wl, err = snap.Workloads(ctx, other)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wl.Tenant).To(Equal(fleetv1alpha1.WorkloadCounts{Total: 2, Pending: 2}))
+
+		// Multi-namespace mode syncs pods into <namespace>-x-<vcluster> instead.
+		multi := syncedPod("web", "tools", corev1.PodRunning, true)
+		multi.Namespace = translate.HostNamespace("tools", "vc-prod")
+		Expect(workloadSummary("vc-prod", "vcluster", []corev1.Pod{*multi}).Tenant.Total).To(BeEquivalentTo(1))
+	})
+
+	It("bounds the namespace breakdown", func() {
+		var objs []client.Object
+		for i := range maxWorkloadNamespaces + 3 {
+			objs = append(objs, syncedPod(fmt.Sprintf("p-x-ns-%02d-x-vc-prod", i), fmt.Sprintf("ns-%02d", i), corev1.PodRunning, true))
+		}
+		objs = append(objs, syncedPod("q-x-ns-22-x-vc-prod", "ns-22", corev1.PodRunning, true))
+		wl, err := newTestSnapshot(objs...).Workloads(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		st := wl.Status()
+		Expect(st.Tenant.Total).To(BeEquivalentTo(maxWorkloadNamespaces + 4))
+		Expect(st.Namespaces).To(HaveLen(maxWorkloadNamespaces))
+		Expect(st.Namespaces[0].Namespace).To(Equal("ns-22"))
+		Expect(st.OmittedNamespaces).To(BeEquivalentTo(3))
+	})
+
+	It("reports mostly pending tenant workloads as degraded", func() {
+		res := workloadSyncDetector{}.Evaluate(ctx, cluster, newTestSnapshot(tenantPods(1, 9)...))
+		Expect(res.Status).To(Equal(metav1.ConditionFalse))
+		Expect(res.Reason).To(Equal("WorkloadsDegraded"))
+		Expect(res.Evidence).To(Equal("10 synced tenant pods for vc-prod, 10% healthy: 1 running (1 ready), 9 pending (minimum 50%)"))
+
+		res = workloadSyncDetector{}.Evaluate(ctx, cluster, newTestSnapshot(tenantPods(9, 1)...))
+		Expect(res.Status).To(Equal(metav1.ConditionTrue))
+		Expect(res.Reason).To(Equal("WorkloadsSynced"))
+
+		snap := newTestSnapshot(tenantPods(9, 1)...)
+		snap.minHealthyPercent = minHealthyPercentFor(fleetv1alpha1.VClusterHealthSpec{
+			Workloads: &fleetv1alpha1.WorkloadsSpec{MinHealthyPercent: ptr.To[int32](100)},
+		})
+		Expect(workloadSyncDetector{}.Evaluate(ctx, cluster, snap).Reason).To(Equal("WorkloadsDegraded"))
+	})
+
+	It("reports the counts in syncCoverage", func() {
+		vh := &fleetv1alpha1.VClusterHealth{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec:       fleetv1alpha1.VClusterHealthSpec{Namespace: "vcluster"},
+		}
+		got, err := reconcileFleet(vh, append(tenantPods(1, 9), apiService("vc-prod", "vcluster", map[string]string{"app": "vcluster"}))...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Status.SyncCoverage).To(HaveLen(1))
+		cov := got.Status.SyncCoverage[0]
+		Expect(cov.TenantWorkloadSync).To(BeFalse())
+		Expect(cov.Workloads).NotTo(BeNil())
+		Expect(cov.Workloads.Tenant).To(Equal(fleetv1alpha1.WorkloadCounts{Total: 10, Running: 1, Pending: 9, Ready: 1}))
+	})
+})
@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package translate implements the vCluster syncer's host naming scheme.
+//
+// The syncer stores a virtual object <name> in <namespace> on the host as
+// <name>-x-<namespace>-x-<vcluster>, and node Services as <vcluster>-node-<node>. Names longer than
+// 63 characters are truncated to 52 characters and suffixed with a hash of the full name, as in
+// vCluster's SafeConcatName. Translating virtual to host is always exact; host to virtual is only
+// possible for names that were not hashed, unless the object carries the syncer's annotations.
+package translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MaxNameLength is the longest name that is not hashed.
+	MaxNameLength = 63
+
+	// hashedPrefixLength is the length of the name kept in front of the hash.
+	hashedPrefixLength = 52
+	// hashLength is the number of hex characters of the hash that are kept.
+	hashLength = 10
+
+	// separator joins name, namespace and vCluster in host names.
+	separator = "-x-"
+	// nodeInfix joins vCluster and node in node Service names.
+	nodeInfix = "-node-"
+
+	// AnnotationObjectName and AnnotationObjectNamespace record the virtual name and namespace on synced objects.
+	AnnotationObjectName      = "vcluster.loft.sh/object-name"
+	AnnotationObjectNamespace = "vcluster.loft.sh/object-namespace"
+)
+
+// SafeConcatName joins parts with "-". Results over MaxNameLength are cut to 52 characters and
+// suffixed with the first 10 hex characters of the SHA-256 of the full name.
+func SafeConcatName(parts ...string) string {
+	full := strings.Join(parts, "-")
+	if len(full) <= MaxNameLength {
+		return full
+	}
+	digest := sha256.Sum256([]byte(full))
+	return strings.ReplaceAll(full[:hashedPrefixLength]+"-"+hex.EncodeToString(digest[:])[:hashLength], ".-", "-")
+}
+
+// HostName returns the host name of the virtual object namespace/name synced by vcluster.
+func HostName(name, namespace, vcluster string) string {
+	return SafeConcatName(name, "x", namespace, "x", vcluster)
+}
+
+// VirtualName returns the virtual name and namespace of the host object named host, synced by
+// vcluster. It fails for hashed names and for names not produced by vcluster. Names containing
+// "-x-" are ambiguous; the namespace is taken to be the part after the last one.
+func VirtualName(host, vcluster string) (name, namespace string, ok bool) {
+	rest, found := strings.CutSuffix(host, separator+vcluster)
+	if !found || isHashed(host) {
+		return "", "", false
+	}
+	i := strings.LastIndex(rest, separator)
+	if i <= 0 || i+len(separator) == len(rest) {
+		return "", "", false
+	}
+	name, namespace = rest[:i], rest[i+len(separator):]
+	if HostName(name, namespace, vcluster) != host {
+		return "", "", false
+	}
+	return name, namespace, true
+}
+
+// VirtualNameOf is VirtualName for a host object, preferring the syncer's annotations so
+// hashed names can be translated too.
+func VirtualNameOf(obj metav1.Object, vcluster string) (name, namespace string, ok bool) {
+	ann := obj.GetAnnotations()
+	name, namespace = ann[AnnotationObjectName], ann[AnnotationObjectNamespace]
+	if name != "" && namespace != "" && HostName(name, namespace, vcluster) == obj.GetName() {
+		return name, namespace, true
+	}
+	return VirtualName(obj.GetName(), vcluster)
+}
+
+// HostNamespace returns the host namespace of the virtual namespace in multi-namespace mode.
+func HostNamespace(namespace, vcluster string) string {
+	return SafeConcatName(namespace, "x", vcluster)
+}
+
+// VirtualNamespace reverses HostNamespace. It fails for hashed names.
+func VirtualNamespace(host, vcluster string) (string, bool) {
+	namespace, found := strings.CutSuffix(host, separator+vcluster)
+	if !found || namespace == "" || isHashed(host) || HostNamespace(namespace, vcluster) != host {
+		return "", false
+	}
+	return namespace, true
+}
+
+// NodeServiceName returns the name of the Service vcluster creates for node. Dots, which
+// Service names cannot hold, become dashes.
+func NodeServiceName(vcluster, node string) string {
+	return SafeConcatName(vcluster, "node", strings.ReplaceAll(node, ".", "-"))
+}
+
+// NodeName reverses NodeServiceName, with dashes in place of the node's dots. It fails for hashed names.
+func NodeName(host, vcluster string) (string, bool) {
+	node, found := strings.CutPrefix(host, vcluster+nodeInfix)
+	if !found || node == "" || isHashed(host) || NodeServiceName(vcluster, node) != host {
+		return "", false
+	}
+	return node, true
+}
+
+// IsNodeService reports whether host names a node Service of vcluster. Unlike NodeName it also
+// accepts hashed names, as long as their kept prefix still holds "<vcluster>-node-".
+func IsNodeService(host, vcluster string) bool {
+	if _, ok := NodeName(host, vcluster); ok {
+		return true
+	}
+	return isHashed(host) && strings.HasPrefix(host, vcluster+nodeInfix)
+}
+
+// isHashed reports whether name has the shape SafeConcatName gives hashed names: 63 characters
+// (62 if a trailing dot was dropped), ending in "-" and 10 hex characters. A 63-character name
+// of that shape is always treated as hashed, since it would otherwise reverse to a bogus name.
+func isHashed(name string) bool {
+	if len(name) != MaxNameLength && len(name) != MaxNameLength-1 {
+		return false
+	}
+	i := len(name) - hashLength - 1
+	if name[i] != '-' {
+		return false
+	}
+	_, err := hex.DecodeString(name[i+1:])
+	return err == nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translate
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("host name translation", func() {
+	longName := strings.Repeat("a", 50)
+
+	It("translates virtual names to host names and back", func() {
+		host := HostName("kube-dns", "kube-system", "vc-prod")
+		Expect(host).To(Equal("kube-dns-x-kube-system-x-vc-prod"))
+
+		name, ns, ok := VirtualName(host, "vc-prod")
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("kube-dns"))
+		Expect(ns).To(Equal("kube-system"))
+	})
+
+	It("does not match a vCluster whose name is a prefix of another", func() {
+		_, _, ok := VirtualName("kube-dns-x-kube-system-x-vc-prod-2", "vc-prod")
+		Expect(ok).To(BeFalse())
+		_, _, ok = VirtualName("kube-dns-x-kube-system-x-vc-prod", "prod")
+		Expect(ok).To(BeFalse())
+		_, ok = NodeName("vc-prod-2-node-worker-1", "vc-prod")
+		Expect(ok).To(BeFalse())
+		_, ok = NodeName("my-vc-prod-node-worker-1", "vc-prod")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("hashes names over 63 characters like vCluster", func() {
+		host := HostName(longName, "default", "vc-prod")
+		Expect(host).To(HaveLen(MaxNameLength))
+		Expect(host).To(HavePrefix(longName + "-x"))
+		Expect(host).To(MatchRegexp(`-[0-9a-f]{10}$`))
+		Expect(HostName(longName, "default", "vc-prod")).To(Equal(host), "hashing is deterministic")
+		Expect(HostName(longName, "default", "vc-dev")).NotTo(Equal(host))
+
+		_, _, ok := VirtualName(host, "vc-prod")
+		Expect(ok).To(BeFalse(), "hashed names cannot be reversed from the name alone")
+	})
+
+	It("reverses hashed names from the syncer annotations", func() {
+		obj := &metav1.ObjectMeta{
+			Name: HostName(longName, "default", "vc-prod"),
+			Annotations: map[string]string{
+				AnnotationObjectName:      longName,
+				AnnotationObjectNamespace: "default",
+			},
+		}
+		name, ns, ok := VirtualNameOf(obj, "vc-prod")
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal(longName))
+		Expect(ns).To(Equal("default"))
+
+		_, _, ok = VirtualNameOf(obj, "vc-dev")
+		Expect(ok).To(BeFalse(), "annotations must agree with the host name")
+	})
+
+	It("translates namespaces and node Services", func() {
+		Expect(HostNamespace("team-a", "vc-prod")).To(Equal("team-a-x-vc-prod"))
+		ns, ok := VirtualNamespace("team-a-x-vc-prod", "vc-prod")
+		Expect(ok).To(BeTrue())
+		Expect(ns).To(Equal("team-a"))
+
+		host := NodeServiceName("vc-prod", "ip-10-0-0-1.ec2.internal")
+		Expect(host).To(Equal("vc-prod-node-ip-10-0-0-1-ec2-internal"))
+		node, ok := NodeName(host, "vc-prod")
+		Expect(ok).To(BeTrue())
+		Expect(node).To(Equal("ip-10-0-0-1-ec2-internal"))
+
+		hashed := NodeServiceName("vc-prod", longName+"-"+longName)
+		_, ok = NodeName(hashed, "vc-prod")
+		Expect(ok).To(BeFalse())
+		Expect(IsNodeService(hashed, "vc-prod")).To(BeTrue())
+		Expect(IsNodeService(hashed, "vc")).To(BeFalse())
+	})
+})